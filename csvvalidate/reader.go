@@ -0,0 +1,284 @@
+package csvvalidate
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"go/ast"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+const TagCSV = "csv"
+
+// NewReader creates Reader to read records of struct typ from r,
+// the first row of r should be the header, columns are mapped to fields by tag `csv`.
+func NewReader(r io.Reader, typ reflect.Type) (*Reader, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv record should be struct, but got %s", typ)
+	}
+
+	ctx := validator.ContextWithNamedTagKey(context.Background(), TagCSV)
+
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string][]int{}
+	collectFields(typ, nil, fields)
+
+	return &Reader{
+		typ:       typ,
+		validator: v,
+		fields:    fields,
+		csv:       csv.NewReader(r),
+		summary: &Summary{
+			ErrorsByColumn: map[string]int{},
+		},
+	}, nil
+}
+
+type Reader struct {
+	typ       reflect.Type
+	validator validator.Validator
+	// field index path by column name
+	fields map[string][]int
+
+	csv     *csv.Reader
+	header  []string
+	columns map[string]int
+	row     int
+	summary *Summary
+}
+
+// Record of each row
+type Record struct {
+	// row number in file, the header is row 1
+	Row int
+	// decoded value of the record
+	Value interface{}
+	// cell errors of the record, empty when valid
+	Errors []*CellError
+	// cell errors of rules with warning severity, which not make the record invalid
+	Warnings []*CellError
+}
+
+func (record *Record) Valid() bool {
+	return len(record.Errors) == 0
+}
+
+type CellError struct {
+	Row int
+	// column number start from 1, 0 when column is missing in header
+	Column int
+	Header string
+	Err    error
+}
+
+func (e *CellError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CellError) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("row %d, missing column %s: %s", e.Row, e.Header, e.Err)
+	}
+	return fmt.Sprintf("row %d, column %d (%s): %s", e.Row, e.Column, e.Header, e.Err)
+}
+
+// Read returns next record, or io.EOF when no more rows
+func (r *Reader) Read() (*Record, error) {
+	if r.header == nil {
+		if err := r.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	cells, err := r.csv.Read()
+	if err != nil {
+		return nil, err
+	}
+	r.row++
+
+	record := &Record{Row: r.row}
+
+	rv := reflect.New(r.typ).Elem()
+	failedColumns := map[string]bool{}
+
+	for i, cell := range cells {
+		if i >= len(r.header) || cell == "" {
+			continue
+		}
+
+		name := r.header[i]
+
+		indexes, ok := r.fields[name]
+		if !ok {
+			continue
+		}
+
+		if err := reflectx.UnmarshalText(fieldByIndex(rv, indexes), []byte(cell)); err != nil {
+			failedColumns[name] = true
+			record.Errors = append(record.Errors, r.cellError(name, err))
+		}
+	}
+
+	err, warnings := errors.Split(r.validator.Validate(rv))
+	if err != nil {
+		if errSet, ok := err.(*errors.ErrorSet); ok {
+			errSet.Flatten().Each(func(fieldErr *errors.FieldError) {
+				name := columnName(fieldErr)
+				// already reported as conversion failure
+				if failedColumns[name] {
+					return
+				}
+				record.Errors = append(record.Errors, r.cellError(name, fieldErr.Error))
+			})
+		} else {
+			record.Errors = append(record.Errors, &CellError{Row: r.row, Err: err})
+		}
+	}
+
+	warnings.Each(func(fieldErr *errors.FieldError) {
+		name := columnName(fieldErr)
+		if failedColumns[name] {
+			return
+		}
+		record.Warnings = append(record.Warnings, r.cellError(name, fieldErr.Error))
+	})
+
+	record.Value = rv.Interface()
+
+	r.summary.Rows++
+	if record.Valid() {
+		r.summary.ValidRows++
+	} else {
+		r.summary.InvalidRows++
+		for _, cellErr := range record.Errors {
+			r.summary.ErrorsByColumn[cellErr.Header]++
+		}
+	}
+
+	return record, nil
+}
+
+// Each calls fn for each record until fn returns error
+func (r *Reader) Each(fn func(record *Record) error) error {
+	for {
+		record, err := r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
+
+// Summary returns data quality summary of rows read
+func (r *Reader) Summary() *Summary {
+	return r.summary
+}
+
+func (r *Reader) readHeader() error {
+	header, err := r.csv.Read()
+	if err != nil {
+		return err
+	}
+	r.row++
+
+	r.header = header
+	r.columns = map[string]int{}
+	for i, name := range header {
+		r.columns[name] = i + 1
+	}
+	return nil
+}
+
+// columnName returns the first key of field path, which is the column name of cell
+func columnName(fieldErr *errors.FieldError) string {
+	if len(fieldErr.Field) > 0 {
+		if name, ok := fieldErr.Field[0].(string); ok {
+			return name
+		}
+	}
+	return fieldErr.Field.String()
+}
+
+func (r *Reader) cellError(name string, err error) *CellError {
+	return &CellError{
+		Row:    r.row,
+		Column: r.columns[name],
+		Header: name,
+		Err:    err,
+	}
+}
+
+type Summary struct {
+	Rows           int
+	ValidRows      int
+	InvalidRows    int
+	ErrorsByColumn map[string]int
+}
+
+func (s *Summary) String() string {
+	b := &strings.Builder{}
+
+	_, _ = fmt.Fprintf(b, "%d rows, %d valid, %d invalid", s.Rows, s.ValidRows, s.InvalidRows)
+
+	columns := make([]string, 0, len(s.ErrorsByColumn))
+	for column := range s.ErrorsByColumn {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		_, _ = fmt.Fprintf(b, "\n%s: %d errors", column, s.ErrorsByColumn[column])
+	}
+
+	return b.String()
+}
+
+func collectFields(typ reflect.Type, parent []int, fields map[string][]int) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, _, exists := typesutil.FieldDisplayName(field.Tag, TagCSV, field.Name)
+		if !ast.IsExported(field.Name) || name == "-" {
+			continue
+		}
+
+		indexes := append(append([]int{}, parent...), i)
+
+		if fieldType := reflectx.Deref(field.Type); field.Anonymous && fieldType.Kind() == reflect.Struct && !exists {
+			collectFields(fieldType, indexes, fields)
+			continue
+		}
+
+		fields[name] = indexes
+	}
+}
+
+func fieldByIndex(rv reflect.Value, indexes []int) reflect.Value {
+	for i, index := range indexes {
+		if i > 0 && rv.Kind() == reflect.Ptr {
+			if rv.IsNil() {
+				rv.Set(reflect.New(rv.Type().Elem()))
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(index)
+	}
+	return rv
+}
@@ -0,0 +1,119 @@
+package csvvalidate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Meta struct {
+	Tag string `csv:"tag" validate:"@string/^[AB]$/"`
+}
+
+type Product struct {
+	SKU   string  `csv:"sku" validate:"@string[3,10]"`
+	Price float64 `csv:"price" validate:"@float64[0,)"`
+	Stock *uint   `csv:"stock" validate:"@uint[0,1000]"`
+	Note  string  `csv:"-"`
+	Meta
+}
+
+func ExampleReader() {
+	data := `sku,price,stock,tag,extra
+abc,1.5,10,A,x
+ab,1.5,10,A,x
+abcd,x,,C,
+`
+
+	r, err := NewReader(strings.NewReader(data), reflect.TypeOf(Product{}))
+	if err != nil {
+		return
+	}
+
+	_ = r.Each(func(record *Record) error {
+		for _, cellErr := range record.Errors {
+			fmt.Println(cellErr)
+		}
+		return nil
+	})
+
+	fmt.Println(r.Summary())
+	// Output:
+	// row 3, column 1 (sku): string length should be larger or equal than 3, but got invalid value 2
+	// row 4, column 2 (price): cannot set value `x`: strconv.ParseFloat: parsing "x": invalid syntax
+	// row 4, column 3 (stock): missing required field
+	// row 4, column 4 (tag): string length ^[AB]$ not match C
+	// 3 rows, 1 valid, 2 invalid
+	// price: 1 errors
+	// sku: 1 errors
+	// stock: 1 errors
+	// tag: 1 errors
+}
+
+func TestReader(t *testing.T) {
+	t.Run("decode values", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("tag,stock,sku,price\nB,3,abcd,0.5\n"), reflect.TypeOf(Product{}))
+		require.NoError(t, err)
+
+		record, err := r.Read()
+		require.NoError(t, err)
+		require.True(t, record.Valid())
+		require.Equal(t, 2, record.Row)
+
+		p := record.Value.(Product)
+		require.Equal(t, "abcd", p.SKU)
+		require.Equal(t, 0.5, p.Price)
+		require.Equal(t, uint(3), *p.Stock)
+		require.Equal(t, "B", p.Tag)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		r, err := NewReader(strings.NewReader("sku,price,stock\nabcd,1,1\n"), reflect.TypeOf(Product{}))
+		require.NoError(t, err)
+
+		record, err := r.Read()
+		require.NoError(t, err)
+		require.Len(t, record.Errors, 1)
+		require.Equal(t, 0, record.Errors[0].Column)
+		require.Equal(t, "row 2, missing column tag: missing required field", record.Errors[0].Error())
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		type Item struct {
+			SKU  string `csv:"sku" validate:"@string[3,10]"`
+			Name string `csv:"name" validate:"@string[,5]" severity:"warn"`
+		}
+
+		r, err := NewReader(strings.NewReader("sku,name\nabcd,too long\nab,too long\n"), reflect.TypeOf(Item{}))
+		require.NoError(t, err)
+
+		record, err := r.Read()
+		require.NoError(t, err)
+		require.True(t, record.Valid())
+		require.Len(t, record.Warnings, 1)
+		require.Equal(t, 2, record.Warnings[0].Column)
+		require.Equal(t, "name", record.Warnings[0].Header)
+
+		record, err = r.Read()
+		require.NoError(t, err)
+		require.False(t, record.Valid())
+		require.Len(t, record.Errors, 1)
+		require.Equal(t, "sku", record.Errors[0].Header)
+		require.Len(t, record.Warnings, 1)
+
+		require.Equal(t, &Summary{
+			Rows:           2,
+			ValidRows:      1,
+			InvalidRows:    1,
+			ErrorsByColumn: map[string]int{"sku": 1},
+		}, r.Summary())
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		_, err := NewReader(strings.NewReader(""), reflect.TypeOf(""))
+		require.Error(t, err)
+	})
+}
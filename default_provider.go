@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-courier/reflectx"
+)
+
+/*
+DefaultValueProvider computes default value for value of typ, referred by default value like `$now`.
+Providers should be registered to ValidatorFactory,
+
+	factory.RegisterDefaultValueProvider("now", DefaultValueProviderNow)
+
+then used by rule `@string = '$now'` or struct tag `default:"$now"`.
+Default values of names not registered, like `$5`, are literals.
+The ctx is the context when compiling, providers could be replaced by ContextWithDefaultValueProvider for tests.
+
+Returned value will be set as it is when assignable or convertible to typ,
+string will be unmarshalled to typ as text, then validated by the rule.
+*/
+type DefaultValueProvider func(ctx context.Context, typ reflect.Type) (interface{}, error)
+
+type contextKeyDefaultValueProviders int
+
+// ContextWithDefaultValueProvider registers provider for rules compiled with the ctx,
+// which will take priority of providers registered to ValidatorFactory.
+func ContextWithDefaultValueProvider(ctx context.Context, name string, provider DefaultValueProvider) context.Context {
+	return contextWithDefaultValueProviders(ctx, map[string]DefaultValueProvider{name: provider}, true)
+}
+
+func DefaultValueProviderFromContext(ctx context.Context, name string) (DefaultValueProvider, bool) {
+	providers, _ := ctx.Value(contextKeyDefaultValueProviders(1)).(map[string]DefaultValueProvider)
+	provider, ok := providers[name]
+	return provider, ok
+}
+
+func contextWithDefaultValueProviders(ctx context.Context, providers map[string]DefaultValueProvider, override bool) context.Context {
+	parent, _ := ctx.Value(contextKeyDefaultValueProviders(1)).(map[string]DefaultValueProvider)
+
+	if !override && containsAllNames(parent, providers) {
+		return ctx
+	}
+
+	merged := make(map[string]DefaultValueProvider, len(parent)+len(providers))
+	for name, provider := range parent {
+		merged[name] = provider
+	}
+	for name, provider := range providers {
+		if _, ok := merged[name]; ok && !override {
+			continue
+		}
+		merged[name] = provider
+	}
+
+	return context.WithValue(ctx, contextKeyDefaultValueProviders(1), merged)
+}
+
+func containsAllNames(parent map[string]DefaultValueProvider, providers map[string]DefaultValueProvider) bool {
+	for name := range providers {
+		if _, ok := parent[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultValueProviderName returns name of provider when default value is `$name`, `$$` is escaped as `$`
+func defaultValueProviderName(defaultValue []byte) (string, bool) {
+	if len(defaultValue) < 2 || defaultValue[0] != '$' || defaultValue[1] == '$' {
+		return "", false
+	}
+	return string(defaultValue[1:]), true
+}
+
+func unescapeDefaultValue(defaultValue []byte) []byte {
+	if bytes.HasPrefix(defaultValue, []byte("$$")) {
+		return defaultValue[1:]
+	}
+	return defaultValue
+}
+
+func setProvidedValue(rv reflect.Value, v interface{}) error {
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+
+	if s, ok := v.(string); ok && rv.Kind() != reflect.String {
+		return reflectx.UnmarshalText(rv, []byte(s))
+	}
+
+	pv := reflect.ValueOf(v)
+	if !pv.IsValid() {
+		return fmt.Errorf("provided value is nil")
+	}
+
+	switch {
+	case pv.Type().AssignableTo(rv.Type()):
+		rv.Set(pv)
+	case pv.Type().ConvertibleTo(rv.Type()) && (pv.Kind() == reflect.String) == (rv.Kind() == reflect.String):
+		rv.Set(pv.Convert(rv.Type()))
+	default:
+		return fmt.Errorf("provided value %T can not set to %s", v, rv.Type())
+	}
+	return nil
+}
+
+var rtypeTime = reflect.TypeOf(time.Time{})
+
+// DefaultValueProviderNow provides current time for time.Time (or types convertible),
+// unix seconds for integers, and RFC3339 text for others.
+// Integers less than 32 bits are not supported.
+func DefaultValueProviderNow(ctx context.Context, typ reflect.Type) (interface{}, error) {
+	now := time.Now()
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		if typ.ConvertibleTo(rtypeTime) {
+			return now, nil
+		}
+	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return now.Unix(), nil
+	case reflect.Int8, reflect.Int16, reflect.Uint8, reflect.Uint16:
+		return nil, fmt.Errorf("unix seconds overflow %s", typ)
+	}
+
+	return now.Format(time.RFC3339Nano), nil
+}
+
+// DefaultValueProviderUUID provides random UUID (version 4), as [16]byte for byte arrays and canonical text for others.
+func DefaultValueProviderUUID(ctx context.Context, typ reflect.Type) (interface{}, error) {
+	id := [16]byte{}
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+
+	if typ.Kind() == reflect.Array && typ.Len() == 16 && typ.Elem().Kind() == reflect.Uint8 {
+		return id, nil
+	}
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16]), nil
+}
+
+// NewDefaultValueProviderSequence creates provider of increasing numbers starting from start,
+// as decimal text for strings.
+func NewDefaultValueProviderSequence(start int64) DefaultValueProvider {
+	next := start - 1
+
+	return func(ctx context.Context, typ reflect.Type) (interface{}, error) {
+		n := atomic.AddInt64(&next, 1)
+		if typ.Kind() == reflect.String {
+			return strconv.FormatInt(n, 10), nil
+		}
+		return n, nil
+	}
+}
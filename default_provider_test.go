@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultValueProvider(t *testing.T) {
+	type Timestamp time.Time
+
+	type Data struct {
+		ID        string     `json:"id,omitempty" default:"$uuid"`
+		RawID     *[16]byte  `json:"rawID,omitempty" default:"$uuid"`
+		CreatedAt time.Time  `json:"createdAt,omitempty" default:"$now"`
+		UpdatedAt *Timestamp `json:"updatedAt,omitempty" default:"$now"`
+		Unix      int64      `json:"unix,omitempty" default:"$now"`
+		Seq       int        `json:"seq,omitempty" default:"$sequence"`
+		SeqText   string     `json:"seqText,omitempty" default:"$sequence"`
+		Dollar    string     `json:"dollar,omitempty" default:"$$now"`
+		Required  string     `json:"required" default:"$uuid"`
+	}
+
+	typ := typesutil.FromRType(reflect.TypeOf(Data{}))
+	ctx := ContextWithNamedTagKey(context.Background(), "json")
+
+	t.Run("built-in providers", func(t *testing.T) {
+		d := Data{}
+		require.NoError(t, ApplyDefaults(&d))
+
+		require.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), d.ID)
+		require.NotEqual(t, [16]byte{}, *d.RawID)
+		require.False(t, d.CreatedAt.IsZero())
+		require.False(t, time.Time(*d.UpdatedAt).IsZero())
+		require.NotZero(t, d.Unix)
+		require.NotZero(t, d.Seq)
+		require.NotEmpty(t, d.SeqText)
+		require.Equal(t, "$now", d.Dollar)
+		// only empty and optional values will be filled
+		require.Empty(t, d.Required)
+
+		d2 := Data{ID: "id"}
+		require.NoError(t, ApplyDefaults(&d2))
+		require.Equal(t, "id", d2.ID)
+	})
+
+	t.Run("deterministic providers by context", func(t *testing.T) {
+		at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		c := ContextWithDefaultValueProvider(ctx, "now", func(ctx context.Context, typ reflect.Type) (interface{}, error) {
+			if typ.Kind() == reflect.Int64 {
+				return at.Unix(), nil
+			}
+			return at, nil
+		})
+		c = ContextWithDefaultValueProvider(c, "uuid", func(ctx context.Context, typ reflect.Type) (interface{}, error) {
+			if typ.Kind() == reflect.Array {
+				return [16]byte{15: 1}, nil
+			}
+			return "00000000-0000-4000-8000-000000000001", nil
+		})
+		c = ContextWithDefaultValueProvider(c, "sequence", NewDefaultValueProviderSequence(10))
+
+		v := ValidatorMgrDefault.MustCompile(c, nil, typ)
+
+		d := Data{}
+		require.NoError(t, ApplyDefaultsBy(v, &d))
+
+		require.Equal(t, "00000000-0000-4000-8000-000000000001", d.ID)
+		require.Equal(t, [16]byte{15: 1}, *d.RawID)
+		require.Equal(t, at, d.CreatedAt)
+		require.Equal(t, at.Unix(), d.Unix)
+		require.Equal(t, Timestamp(at), *d.UpdatedAt)
+		require.Equal(t, 10, d.Seq)
+		require.Equal(t, "11", d.SeqText)
+	})
+
+	t.Run("by factory", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&StructValidator{}, &StringValidator{})
+		f.RegisterDefaultValueProvider("tenant", func(ctx context.Context, typ reflect.Type) (interface{}, error) {
+			return "default-tenant", nil
+		})
+
+		type Scoped struct {
+			Tenant string `json:"tenant,omitempty" validate:"@string[1,]" default:"$tenant"`
+		}
+
+		v := f.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(Scoped{})))
+
+		s := Scoped{}
+		require.NoError(t, v.Validate(&s))
+		require.Equal(t, "default-tenant", s.Tenant)
+
+		s = Scoped{}
+		require.NoError(t, f.ApplyDefaults(&s))
+		require.Equal(t, "default-tenant", s.Tenant)
+		require.Error(t, f.ApplyDefaults(s))
+
+		// not registered in ValidatorMgrDefault
+		s = Scoped{}
+		require.NoError(t, ApplyDefaults(&s))
+		require.Equal(t, "$tenant", s.Tenant)
+	})
+
+	t.Run("unregistered names are literals", func(t *testing.T) {
+		type Price struct {
+			Amount   string `json:"amount,omitempty" default:"$5"`
+			Currency string `json:"currency,omitempty" default:"$USD"`
+		}
+
+		p := Price{}
+		require.NoError(t, ApplyDefaults(&p))
+		require.Equal(t, "$5", p.Amount)
+		require.Equal(t, "$USD", p.Currency)
+
+		type Invalid struct {
+			Count int `json:"count,omitempty" validate:"@int" default:"$1"`
+		}
+
+		_, err := ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(reflect.TypeOf(Invalid{})))
+		require.Error(t, err)
+	})
+
+	t.Run("provided value should be validated", func(t *testing.T) {
+		type Short struct {
+			Code string `json:"code,omitempty" validate:"@string[1,5]" default:"$uuid"`
+		}
+
+		v := ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(Short{})))
+
+		require.Error(t, v.Validate(&Short{}))
+		require.Error(t, ApplyDefaultsBy(v, &Short{}))
+	})
+
+	t.Run("literal default value", func(t *testing.T) {
+		compile := func(rule string) *ValidatorLoader {
+			return ValidatorMgrDefault.MustCompile(ctx, []byte(rule), typesutil.FromRType(reflect.TypeOf(""))).(*ValidatorLoader)
+		}
+
+		provided := compile("@string = '$now'")
+		require.Nil(t, provided.LiteralDefaultValue())
+		name, ok := provided.DefaultValueProviderName()
+		require.True(t, ok)
+		require.Equal(t, "now", name)
+
+		escaped := compile("@string = '$$now'")
+		require.Equal(t, "$now", string(escaped.LiteralDefaultValue()))
+		_, ok = escaped.DefaultValueProviderName()
+		require.False(t, ok)
+
+		require.Equal(t, "$USD", string(compile("@string = '$USD'").LiteralDefaultValue()))
+		require.Nil(t, compile("@string").LiteralDefaultValue())
+	})
+
+	t.Run("now of small integers", func(t *testing.T) {
+		_, err := DefaultValueProviderNow(context.Background(), reflect.TypeOf(int16(0)))
+		require.Error(t, err)
+
+		v, err := DefaultValueProviderNow(context.Background(), reflect.TypeOf(uint32(0)))
+		require.NoError(t, err)
+		require.IsType(t, int64(0), v)
+	})
+}
@@ -0,0 +1,250 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"reflect"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+)
+
+type contextKeyReadOnly int
+
+// ContextWithReadOnly makes validators compiled with the ctx side-effect free,
+// default values will not be set during Validate, use ApplyDefaults instead.
+func ContextWithReadOnly(ctx context.Context, readOnly bool) context.Context {
+	return context.WithValue(ctx, contextKeyReadOnly(1), readOnly)
+}
+
+func ReadOnlyFromContext(ctx context.Context) bool {
+	readOnly, _ := ctx.Value(contextKeyReadOnly(1)).(bool)
+	return readOnly
+}
+
+func isReadOnlySet(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKeyReadOnly(1)).(bool)
+	return ok
+}
+
+// defaultsApplier is implemented by validators which could fill default values of settable rv,
+// depth is the count of recursive struct values walked through, like scope.Depth() in validating
+type defaultsApplier interface {
+	applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int)
+}
+
+/*
+ApplyDefaults fills default values of optional fields through nested structs, slices and maps of ptr,
+by the validator compiled from the type of ptr with ValidatorMgrDefault and named tag key `json`.
+Use (*ValidatorFactory).ApplyDefaults for other factories,
+or ApplyDefaultsBy with the compiled validator for other ValidatorMgr or named tag key.
+
+	type Config struct {
+		Port int `json:"port,omitempty" default:"80"`
+	}
+
+	c := Config{}
+	err := ApplyDefaults(&c) // c.Port == 80
+*/
+func ApplyDefaults(ptr interface{}) error {
+	return ValidatorMgrDefault.ApplyDefaults(ptr)
+}
+
+// ApplyDefaults fills default values of ptr by the validator compiled from the type of ptr with named tag key `json`,
+// registered providers, type rules and max depth of the factory will be used.
+func (f *ValidatorFactory) ApplyDefaults(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("ApplyDefaults need a non-nil pointer, but got %T", ptr)
+	}
+
+	v, err := f.CompileCached("json", nil, rv.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	return ApplyDefaultsBy(v, ptr)
+}
+
+// ApplyDefaultsBy fills default values of ptr by the compiled validator of its elem type
+func ApplyDefaultsBy(validator Validator, ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("ApplyDefaultsBy need a non-nil pointer, but got %T", ptr)
+	}
+
+	errSet := errors.NewErrorSet("")
+	applyDefaults(validator, rv.Elem(), errSet, errors.KeyPath{}, 0)
+	return errSet.Err()
+}
+
+func applyDefaults(validator Validator, rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	if applier, ok := validator.(defaultsApplier); ok {
+		applier.applyDefaults(rv, errSet, keyPath, depth)
+	}
+}
+
+func (loader *ValidatorLoader) applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	if loader.shouldApplyDefault(rv) {
+		if err := loader.setDefaultValue(rv); err != nil {
+			errSet.AddErr(fmt.Errorf("unmarshal default value failed: %s", err), keyPath...)
+			return
+		}
+		// provided values could not be validated when compiling
+		if loader.provideDefaultValue != nil {
+			if err := loader.finalizeErr(loader.validateValue(rv, nil)); err != nil {
+				errSet.AddErr(err, keyPath...)
+			}
+		}
+		return
+	}
+
+	// values of text marshaler or driver.Valuer are leaves
+	if loader.PreprocessStage == PreprocessString || loader.PreprocessStage == PreprocessValuer {
+		return
+	}
+
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+
+	applyDefaults(loader.Validator, rv, errSet, keyPath, depth)
+}
+
+// shouldApplyDefault returns true when default value should be set to the missing rv
+func (loader *ValidatorLoader) shouldApplyDefault(rv reflect.Value) bool {
+	if !loader.Optional || loader.DefaultValue == nil || !rv.CanSet() {
+		return false
+	}
+	return loader.isMissing(rv)
+}
+
+func (loader *ValidatorLoader) setDefaultValue(rv reflect.Value) error {
+	if loader.provideDefaultValue != nil {
+		v, err := loader.provideDefaultValue(reflectx.Deref(rv.Type()))
+		if err != nil {
+			return err
+		}
+		return setProvidedValue(rv, v)
+	}
+	return setDefaultValue(rv, unescapeDefaultValue(loader.DefaultValue))
+}
+
+// setDefaultValue sets default value to rv.
+// Default values of slices, arrays, maps and structs are JSON literals, like `["a","b"]`,
+// which will be decoded for each call, so values filled will not share backing arrays.
+func setDefaultValue(rv reflect.Value, data []byte) error {
+	if isValuerType(typesutil.FromRType(rv.Type())) {
+		if ok, err := scanValue(rv, data); ok {
+			return err
+		}
+	}
+
+	if !isCompositeType(rv.Type()) {
+		return reflectx.UnmarshalText(rv, data)
+	}
+
+	v := reflect.New(reflectx.Deref(rv.Type()))
+
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.DisallowUnknownFields()
+	if err := d.Decode(v.Interface()); err != nil {
+		return fmt.Errorf("cannot set value `%s`: %s", data, err)
+	}
+
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+	rv.Set(v.Elem())
+	return nil
+}
+
+func isCompositeType(typ reflect.Type) bool {
+	typ = reflectx.Deref(typ)
+	if reflect.PtrTo(typ).Implements(typTextUnmarshaler) {
+		return false
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		return true
+	}
+	return false
+}
+
+func (validator *StructValidator) applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	// values of recursive struct types could be nested too deep or even in cycle by pointers
+	if validator.recursive {
+		if depth >= validator.maxDepth {
+			errSet.AddErr(&errors.MaxDepthExceededError{MaxDepth: validator.maxDepth}, keyPath...)
+			return
+		}
+		depth++
+	}
+	validator.applyFieldDefaults(rv, errSet, keyPath, depth)
+}
+
+func (validator *StructValidator) applyFieldDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	typ := rv.Type()
+
+	for i := 0; i < rv.NumField(); i++ {
+		field := typ.Field(i)
+		fieldValue := rv.Field(i)
+		fieldName, _, exists := typesutil.FieldDisplayName(field.Tag, validator.namedTagKey, field.Name)
+
+		if !ast.IsExported(field.Name) || fieldName == "-" {
+			continue
+		}
+
+		if field.Anonymous && reflectx.Deref(field.Type).Kind() == reflect.Struct && !exists {
+			// nil embedded struct is kept as it is
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					continue
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			validator.applyFieldDefaults(fieldValue, errSet, keyPath, depth)
+			continue
+		}
+
+		if fieldValidator, ok := validator.fieldValidators[field.Name]; ok {
+			applyDefaults(fieldValidator, fieldValue, errSet, childKeyPath(keyPath, fieldName), depth)
+		}
+	}
+}
+
+func (validator *SliceValidator) applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	if validator.ElemValidator == nil {
+		return
+	}
+	for i := 0; i < rv.Len(); i++ {
+		applyDefaults(validator.ElemValidator, rv.Index(i), errSet, childKeyPath(keyPath, i), depth)
+	}
+}
+
+func (validator *MapValidator) applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	if validator.ElemValidator == nil {
+		return
+	}
+
+	iter := rv.MapRange()
+	for iter.Next() {
+		// elements of map are not addressable, apply on copies and put them back
+		elem := reflect.New(rv.Type().Elem()).Elem()
+		elem.Set(iter.Value())
+
+		applyDefaults(validator.ElemValidator, elem, errSet, childKeyPath(keyPath, fmt.Sprintf("%v", iter.Key().Interface())), depth)
+
+		rv.SetMapIndex(iter.Key(), elem)
+	}
+}
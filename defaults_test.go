@@ -0,0 +1,184 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	type Sub struct {
+		Name string `json:"name,omitempty" default:"sub"`
+	}
+
+	type Embedded struct {
+		Level int `json:"level,omitempty" default:"1"`
+	}
+
+	type Data struct {
+		Port     int            `json:"port,omitempty" default:"80"`
+		Host     string         `json:"host" default:"localhost"`
+		PtrPort  *int           `json:"ptrPort,omitempty" default:"8080"`
+		Sub      Sub            `json:"sub"`
+		PtrSub   *Sub           `json:"ptrSub,omitempty"`
+		Subs     []Sub          `json:"subs,omitempty"`
+		SubMap   map[string]Sub `json:"subMap,omitempty" validate:"@map<@string[1,],>"`
+		Duration Duration       `json:"duration,omitempty" default:"1s"`
+		Embedded
+	}
+
+	t.Run("fill defaults", func(t *testing.T) {
+		d := Data{
+			Port:   1,
+			PtrSub: &Sub{},
+			Subs:   []Sub{{}, {Name: "a"}},
+			SubMap: map[string]Sub{"x": {}},
+		}
+		require.NoError(t, ApplyDefaults(&d))
+
+		require.Equal(t, 1, d.Port)
+		// required field will not be filled
+		require.Equal(t, "", d.Host)
+		require.Equal(t, 8080, *d.PtrPort)
+		require.Equal(t, "sub", d.Sub.Name)
+		require.Equal(t, "sub", d.PtrSub.Name)
+		require.Equal(t, []Sub{{Name: "sub"}, {Name: "a"}}, d.Subs)
+		require.Equal(t, map[string]Sub{"x": {Name: "sub"}}, d.SubMap)
+		require.Equal(t, Duration(time.Second), d.Duration)
+		require.Equal(t, 1, d.Level)
+	})
+
+	t.Run("need pointer", func(t *testing.T) {
+		require.Error(t, ApplyDefaults(Data{}))
+		require.Error(t, ApplyDefaults((*Data)(nil)))
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	type Data struct {
+		Port int `json:"port,omitempty" default:"80"`
+	}
+
+	typ := typesutil.FromRType(reflect.TypeOf(Data{}))
+	ctx := ContextWithNamedTagKey(context.Background(), "json")
+
+	t.Run("by context", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(ContextWithReadOnly(ctx, true), nil, typ)
+
+		d := Data{}
+		require.NoError(t, v.Validate(&d))
+		require.Equal(t, 0, d.Port)
+
+		require.NoError(t, ApplyDefaultsBy(v, &d))
+		require.Equal(t, 80, d.Port)
+	})
+
+	t.Run("by factory", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&StructValidator{}, &IntValidator{})
+		f.SetReadOnly(true)
+
+		d := Data{}
+		require.NoError(t, f.MustCompile(ctx, nil, typ).Validate(&d))
+		require.Equal(t, 0, d.Port)
+
+		require.NoError(t, f.MustCompile(ContextWithReadOnly(ctx, false), nil, typ).Validate(&d))
+		require.Equal(t, 80, d.Port)
+	})
+}
+
+func ExampleApplyDefaults() {
+	type Config struct {
+		Host string   `json:"host,omitempty" default:"localhost"`
+		Port int      `json:"port,omitempty" default:"80"`
+		Tags []string `json:"tags,omitempty"`
+	}
+
+	c := Config{Port: 8080}
+	if err := ApplyDefaults(&c); err != nil {
+		return
+	}
+	fmt.Println(c.Host, c.Port)
+	// Output:
+	// localhost 8080
+}
+
+func TestCompositeDefaults(t *testing.T) {
+	type Sub struct {
+		Name string `json:"name" validate:"@string[1,]"`
+	}
+
+	type Data struct {
+		Tags   []string          `json:"tags,omitempty" validate:"@slice<@string[1,]>[1,3]" default:"[\"a\",\"b\"]"`
+		Labels map[string]string `json:"labels,omitempty" default:"{\"k\":\"v\"}"`
+		PtrSub *Sub              `json:"ptrSub,omitempty" default:"{\"name\":\"p\"}"`
+		Points *[2]int           `json:"points,omitempty" default:"[1,2]"`
+	}
+
+	typ := typesutil.FromRType(reflect.TypeOf(Data{}))
+	v := ValidatorMgrDefault.MustCompile(ContextWithNamedTagKey(context.Background(), "json"), nil, typ)
+
+	d1, d2 := Data{}, Data{}
+	require.NoError(t, ApplyDefaultsBy(v, &d1))
+	require.NoError(t, v.Validate(&d2))
+
+	for _, d := range []Data{d1, d2} {
+		require.Equal(t, []string{"a", "b"}, d.Tags)
+		require.Equal(t, map[string]string{"k": "v"}, d.Labels)
+		require.Equal(t, &Sub{Name: "p"}, d.PtrSub)
+		require.Equal(t, &[2]int{1, 2}, d.Points)
+	}
+
+	t.Run("not shared", func(t *testing.T) {
+		d1.Tags[0] = "changed"
+		d1.Labels["k"] = "changed"
+		d1.PtrSub.Name = "changed"
+
+		require.Equal(t, []string{"a", "b"}, d2.Tags)
+		require.Equal(t, map[string]string{"k": "v"}, d2.Labels)
+		require.Equal(t, "p", d2.PtrSub.Name)
+	})
+
+	t.Run("zero struct and array are not missing", func(t *testing.T) {
+		type Point struct {
+			X int `json:"x,omitempty"`
+		}
+
+		type Kept struct {
+			Point  Point  `json:"point,omitempty" default:"{\"x\":1}"`
+			Points [2]int `json:"points,omitempty" default:"[1,2]"`
+		}
+
+		d := Kept{}
+		require.NoError(t, ApplyDefaults(&d))
+		require.Equal(t, Kept{}, d)
+	})
+
+	t.Run("invalid defaults", func(t *testing.T) {
+		cases := []interface{}{
+			struct {
+				Tags []string `json:"tags,omitempty" validate:"@slice<@string[1,]>[1,3]" default:"[\"a\",\"b\",\"c\",\"d\"]"`
+			}{},
+			struct {
+				Tags []string `json:"tags,omitempty" default:"a,b"`
+			}{},
+			struct {
+				Sub Sub `json:"sub,omitempty" default:"{\"unknown\":1}"`
+			}{},
+			struct {
+				Sub Sub `json:"sub,omitempty" default:"{\"name\":\"\"}"`
+			}{},
+		}
+
+		for i := range cases {
+			_, err := ValidatorMgrDefault.Compile(ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(reflect.TypeOf(cases[i])))
+			require.Error(t, err)
+			t.Log(err)
+		}
+	})
+}
@@ -0,0 +1,307 @@
+package validator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-courier/validator/errors"
+)
+
+// Describer could be implemented by validators to describe constraints for humans
+type Describer interface {
+	Describe() *Description
+}
+
+// Describe returns description of validator, nil when validator is not a Describer
+func Describe(validator Validator) *Description {
+	if describer, ok := validator.(Describer); ok {
+		return describer.Describe()
+	}
+	return nil
+}
+
+// value types of Description
+const (
+	DescriptionTypeString  = "string"
+	DescriptionTypeInteger = "integer"
+	DescriptionTypeUint    = "unsigned integer"
+	DescriptionTypeNumber  = "number"
+	DescriptionTypeList    = "list"
+	DescriptionTypeMap     = "map"
+	DescriptionTypeObject  = "object"
+)
+
+// DescriptionCodeType is the code to translate Description.Type
+const DescriptionCodeType = "type"
+
+// Description is structured description of validator
+type Description struct {
+	Type        string        `json:"type"`
+	Constraints []*Constraint `json:"constraints,omitempty"`
+	// description of keys of map
+	Key *Description `json:"key,omitempty"`
+	// description of elements of list or values of map
+	Elem *Description `json:"elem,omitempty"`
+
+	Optional     bool   `json:"optional,omitempty"`
+	DefaultValue string `json:"defaultValue,omitempty"`
+	// name of type documented elsewhere, rendered as `see Ref`
+	Ref string `json:"ref,omitempty"`
+}
+
+/*
+Constraint of value.
+Code and Target are same as the error raised when constraint violated,
+like errors.CodeOutOfRange with TargetStringLength, so both could be localized in same way.
+
+Params by Code:
+
+	errors.CodeOutOfRange: minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+		lenMode for TargetStringLength,
+		maxDigits, decimalDigits for TargetTotalDigitsOfFloatValue
+	errors.CodeNotInEnum: enums
+	errors.CodeNotMatch: pattern or format
+	errors.CodeMultipleOf: multipleOf
+*/
+type Constraint struct {
+	Code   string                 `json:"code"`
+	Target string                 `json:"target"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// DescriptionTranslator localizes type or constraint by code, target and params,
+// returns false to fall back to the default English text.
+// Code will be DescriptionCodeType for Description.Type, and target will be the type.
+type DescriptionTranslator func(code string, target string, params map[string]interface{}) (string, bool)
+
+// String returns English prose, like `integer between 1 and 10 inclusive, multiple of 2`
+func (d *Description) String() string {
+	return d.Text(nil)
+}
+
+// Text returns prose localized by translate
+func (d *Description) Text(translate DescriptionTranslator) string {
+	if d == nil {
+		return ""
+	}
+
+	text := d.Type
+	if translate != nil {
+		if s, ok := translate(DescriptionCodeType, d.Type, nil); ok {
+			text = s
+		}
+	}
+
+	parts := make([]string, 0, len(d.Constraints)+2)
+
+	for _, c := range d.Constraints {
+		if translate != nil {
+			if s, ok := translate(c.Code, c.Target, c.Params); ok {
+				parts = append(parts, s)
+				continue
+			}
+		}
+		parts = append(parts, c.String())
+	}
+
+	if d.Key != nil {
+		parts = append(parts, "keys ("+d.Key.Text(translate)+")")
+	}
+
+	if d.Elem != nil {
+		if d.Type == DescriptionTypeMap {
+			parts = append(parts, "values ("+d.Elem.Text(translate)+")")
+		} else {
+			parts = append(parts, "each ("+d.Elem.Text(translate)+")")
+		}
+	}
+
+	if len(parts) > 0 {
+		text += " " + strings.Join(parts, ", ")
+	}
+
+	if d.Ref != "" {
+		text += ", see " + d.Ref
+	}
+
+	return text
+}
+
+// String returns English text of constraint
+func (c *Constraint) String() string {
+	switch c.Code {
+	case errors.CodeOutOfRange:
+		switch c.Target {
+		case TargetTotalDigitsOfFloatValue:
+			return fmt.Sprintf("with up to %v digits and %s", c.Params["maxDigits"], plural(c.Params["decimalDigits"], "decimal place"))
+		case TargetStringLength:
+			if c.Params["lenMode"] == STR_LEN_MODE__RUNE_COUNT.String() {
+				return "with " + lengthText(c.Params, "character") + " (rune count)"
+			}
+			return "with " + lengthText(c.Params, "byte")
+		case TargetSliceLength:
+			return "with " + lengthText(c.Params, "item")
+		case TargetMapLength:
+			return "with " + lengthText(c.Params, "entry")
+		}
+		return rangeText(c.Params)
+	case errors.CodeNotInEnum:
+		values := make([]string, 0)
+		if enums, ok := c.Params["enums"].([]string); ok {
+			values = enums
+		}
+		return "one of " + strings.Join(values, ", ")
+	case errors.CodeNotMatch:
+		if format, ok := c.Params["format"]; ok {
+			return fmt.Sprintf("in %s format", format)
+		}
+		return fmt.Sprintf("matching /%s/", c.Params["pattern"])
+	case errors.CodeMultipleOf:
+		return fmt.Sprintf("multiple of %v", c.Params["multipleOf"])
+	}
+	return c.Code
+}
+
+func lengthText(params map[string]interface{}, unit string) string {
+	min, hasMin := params["minimum"]
+	max, hasMax := params["maximum"]
+
+	switch {
+	case hasMin && hasMax:
+		if fmt.Sprint(min) == fmt.Sprint(max) {
+			return "exactly " + plural(min, unit)
+		}
+		return fmt.Sprintf("%v–%s", min, plural(max, unit))
+	case hasMin:
+		return "at least " + plural(min, unit)
+	case hasMax:
+		return "at most " + plural(max, unit)
+	}
+	return "any " + unit
+}
+
+func rangeText(params map[string]interface{}) string {
+	min, hasMin := params["minimum"]
+	max, hasMax := params["maximum"]
+	exclusiveMin := params["exclusiveMinimum"] == true
+	exclusiveMax := params["exclusiveMaximum"] == true
+
+	switch {
+	case hasMin && hasMax:
+		if !exclusiveMin && !exclusiveMax {
+			return fmt.Sprintf("between %v and %v inclusive", min, max)
+		}
+		if exclusiveMin && exclusiveMax {
+			return fmt.Sprintf("between %v and %v exclusive", min, max)
+		}
+		return fmt.Sprintf("between %v (%s) and %v (%s)", min, inclusiveText(exclusiveMin), max, inclusiveText(exclusiveMax))
+	case hasMin:
+		if exclusiveMin {
+			return fmt.Sprintf("greater than %v", min)
+		}
+		return fmt.Sprintf("at least %v", min)
+	case hasMax:
+		if exclusiveMax {
+			return fmt.Sprintf("less than %v", max)
+		}
+		return fmt.Sprintf("at most %v", max)
+	}
+	return "any value"
+}
+
+func inclusiveText(exclusive bool) string {
+	if exclusive {
+		return "exclusive"
+	}
+	return "inclusive"
+}
+
+func plural(n interface{}, unit string) string {
+	s := fmt.Sprint(n)
+	if s == "1" {
+		return s + " " + unit
+	}
+	if strings.HasSuffix(unit, "y") {
+		return s + " " + strings.TrimSuffix(unit, "y") + "ies"
+	}
+	return s + " " + unit + "s"
+}
+
+func rangeConstraint(target string, minimum interface{}, maximum interface{}, exclusiveMinimum bool, exclusiveMaximum bool) *Constraint {
+	if minimum == nil && maximum == nil {
+		return nil
+	}
+
+	c := &Constraint{
+		Code:   errors.CodeOutOfRange,
+		Target: target,
+		Params: map[string]interface{}{},
+	}
+
+	if minimum != nil {
+		c.Params["minimum"] = minimum
+		if exclusiveMinimum {
+			c.Params["exclusiveMinimum"] = true
+		}
+	}
+
+	if maximum != nil {
+		c.Params["maximum"] = maximum
+		if exclusiveMaximum {
+			c.Params["exclusiveMaximum"] = true
+		}
+	}
+
+	return c
+}
+
+func lengthConstraint(target string, minimum uint64, maximum *uint64) *Constraint {
+	if minimum == 0 && maximum == nil {
+		return nil
+	}
+	if maximum == nil {
+		return rangeConstraint(target, minimum, nil, false, false)
+	}
+	if minimum == 0 {
+		return rangeConstraint(target, nil, *maximum, false, false)
+	}
+	return rangeConstraint(target, minimum, *maximum, false, false)
+}
+
+func enumConstraint(target string, enums interface{}) *Constraint {
+	return enumListConstraint(target, EnumValues(enums))
+}
+
+func enumListConstraint(target string, values []string) *Constraint {
+	return &Constraint{
+		Code:   errors.CodeNotInEnum,
+		Target: target,
+		Params: map[string]interface{}{"enums": values},
+	}
+}
+
+func patternConstraint(target string, pattern *regexp.Regexp) *Constraint {
+	return &Constraint{
+		Code:   errors.CodeNotMatch,
+		Target: target,
+		Params: map[string]interface{}{"pattern": pattern.String()},
+	}
+}
+
+func multipleOfConstraint(target string, multipleOf interface{}) *Constraint {
+	return &Constraint{
+		Code:   errors.CodeMultipleOf,
+		Target: target,
+		Params: map[string]interface{}{"multipleOf": multipleOf},
+	}
+}
+
+func (d *Description) add(constraints ...*Constraint) *Description {
+	for _, c := range constraints {
+		if c != nil {
+			d.Constraints = append(d.Constraints, c)
+		}
+	}
+	return d
+}
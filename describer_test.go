@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleDescribe() {
+	rules := []struct {
+		rule string
+		typ  interface{}
+	}{
+		{"@int[1,10]{%2}", 0},
+		{"@int(0,100]", 0},
+		{"@uint[1,]", uint(0)},
+		{"@float<5,2>[0,100)", float64(0)},
+		{"@char[1,10]", ""},
+		{"@string[6]", ""},
+		{"@string{A,B,C}", ""},
+		{"@string/^\\w+$/", ""},
+		{"@slice<@string[1,]>[,3]", []string{}},
+		{"@map<@string{A,B},@int[1,]>[1,]", map[string]int{}},
+	}
+
+	for _, r := range rules {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte(r.rule), typesutil.FromRType(reflect.TypeOf(r.typ)))
+		fmt.Println(Describe(v))
+	}
+	// Output:
+	// integer between 1 and 10 inclusive, multiple of 2
+	// integer between 0 (exclusive) and 100 (inclusive)
+	// unsigned integer at least 1
+	// number with up to 5 digits and 2 decimal places, between 0 (inclusive) and 100 (exclusive)
+	// string with 1–10 characters (rune count)
+	// string with exactly 6 bytes
+	// string one of A, B, C
+	// string matching /^\w+$/
+	// list with at most 3 items, each (string with at least 1 byte)
+	// map with at least 1 entry, keys (string one of A, B), values (integer at least 1)
+}
+
+func TestDescribe(t *testing.T) {
+	t.Run("structured", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int[1,10] = 5"), typesutil.FromRType(reflect.TypeOf(0)))
+
+		d := Describe(v)
+		require.True(t, d.Optional)
+		require.Equal(t, "5", d.DefaultValue)
+
+		data, err := json.Marshal(d)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"type":"integer","constraints":[{"code":"out_of_range","target":"int value","params":{"minimum":1,"maximum":10}}],"optional":true,"defaultValue":"5"}`, string(data))
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int[1,10]{%2}"), typesutil.FromRType(reflect.TypeOf(0)))
+
+		text := Describe(v).Text(func(code string, target string, params map[string]interface{}) (string, bool) {
+			switch code {
+			case DescriptionCodeType:
+				return "整数", true
+			case errors.CodeOutOfRange:
+				return fmt.Sprintf("%v 到 %v 之间", params["minimum"], params["maximum"]), true
+			}
+			return "", false
+		})
+
+		require.Equal(t, "整数 1 到 10 之间, multiple of 2", text)
+	})
+
+	t.Run("strfmt", func(t *testing.T) {
+		v := NewRegexpStrfmtValidator("^\\d+$", "number-string")
+		require.Equal(t, "string in number-string format", Describe(v).String())
+	})
+
+	t.Run("not describer", func(t *testing.T) {
+		require.Nil(t, Describe(nil))
+
+		v := ValidatorMgrDefault.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(true)))
+		require.Nil(t, Describe(v))
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(struct{}{})))
+		require.Equal(t, "object", Describe(v).String())
+	})
+
+	t.Run("ref", func(t *testing.T) {
+		d := &Description{Type: DescriptionTypeMap, Elem: &Description{Type: DescriptionTypeObject, Ref: "Contact"}}
+		require.Equal(t, "map values (object, see Contact)", d.String())
+	})
+}
@@ -30,6 +30,17 @@ There are common 'Rule DSL' below:
 	@name?
 	@name = value
 	@name = 'some string value'
+	// default value of slice, map and struct should be json literal
+	@name = '["a","b"]'
+	// default value computed by provider registered to ValidatorFactory, `$$` for literal `$`
+	@name = '$now'
+
+	// presence, only nil value is missing, zero value will be validated
+	@name!
+	@name!?
+
+	// values of driver.Valuer (like sql.NullString) are unwrapped, invalid (NULL) value is missing
+	@string[1,10] // on sql.NullString
 
 	// composes
 	@map<@string[1,10],@string{A,B,C}>
@@ -51,6 +62,14 @@ Then the parsed rule will be transform to special validators:
 
 @slice: https://godoc.org/github.com/go-courier/validator#SliceValidator
 
+@ip: https://godoc.org/github.com/go-courier/validator#IPValidator
+
+@prefix: https://godoc.org/github.com/go-courier/validator#PrefixValidator
+
+@url: https://godoc.org/github.com/go-courier/validator#URLValidator
+
+@mail: https://godoc.org/github.com/go-courier/validator#MailValidator
+
 
 Validating
 
@@ -0,0 +1,295 @@
+package docgen
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+)
+
+// Table documents fields of named struct
+type Table struct {
+	Name string
+	Rows []*Row
+}
+
+type Row struct {
+	// display name of field
+	Field    string
+	Type     string
+	Required bool
+	// only null is missing, zero values are valid when the rule allows
+	Presence bool
+	Default  string
+	// name of validator.DefaultValueProvider which computes default value, like `now`
+	DefaultProvider string
+	// human-readable constraint description, like `string with 1–10 characters (rune count)`
+	Description string
+}
+
+func NewGenerator(namedTagKey string) *Generator {
+	return &Generator{
+		NamedTagKey: namedTagKey,
+		declared:    map[string]bool{},
+	}
+}
+
+// Generator generates tables of named struct types, nested named structs will be documented in tables after them.
+type Generator struct {
+	NamedTagKey string
+
+	Tables   []*Table
+	declared map[string]bool
+}
+
+// Add compiles named struct type and documents it
+func (g *Generator) Add(typ reflect.Type) error {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ.Name() == "" {
+		return fmt.Errorf("%s should be a named struct", typ)
+	}
+
+	ctx := validator.ContextWithNamedTagKey(context.Background(), g.NamedTagKey)
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return err
+	}
+
+	g.table(typesutil.FromRType(typ), validator.UnwrapValidatorLoader(v).(*validator.StructValidator))
+	return nil
+}
+
+func (g *Generator) table(typ typesutil.Type, structValidator *validator.StructValidator) {
+	if g.declared[typ.Name()] {
+		return
+	}
+	g.declared[typ.Name()] = true
+
+	t := &Table{Name: typ.Name()}
+	g.Tables = append(g.Tables, t)
+
+	g.rows(t, structValidator, "")
+}
+
+func (g *Generator) rows(t *Table, structValidator *validator.StructValidator, prefix string) {
+	nested := make([]func(), 0)
+
+	for _, field := range structValidator.Fields() {
+		row := &Row{
+			Field: prefix + field.DisplayName,
+			Type:  field.Type.String(),
+		}
+
+		v := field.Validator
+		if loader, ok := v.(*validator.ValidatorLoader); ok {
+			row.Required = !loader.Optional
+			row.Presence = loader.ZeroValidated()
+			if defaultValue := loader.LiteralDefaultValue(); defaultValue != nil {
+				row.Default = string(defaultValue)
+			}
+			row.DefaultProvider, _ = loader.DefaultValueProviderName()
+			v = validator.UnwrapValidatorLoader(loader)
+		}
+
+		row.Description = describe(field.Type, v).String()
+		t.Rows = append(t.Rows, row)
+
+		if structValidator, ok := v.(*validator.StructValidator); ok {
+			fieldType := typesutil.Deref(field.Type)
+			if fieldType.Name() == "" {
+				// fields of anonymous struct are documented in same table
+				g.rows(t, structValidator, row.Field+".")
+				continue
+			}
+			nested = append(nested, func() {
+				g.table(fieldType, structValidator)
+			})
+		}
+
+		eachNamedStruct(field.Type, v, func(typ typesutil.Type, structValidator *validator.StructValidator) {
+			nested = append(nested, func() {
+				g.table(typ, structValidator)
+			})
+		})
+	}
+
+	for i := range nested {
+		nested[i]()
+	}
+}
+
+// describe describes validator by validator.Describe,
+// named structs are referred by their names, which are documented in their own tables
+func describe(typ typesutil.Type, v validator.Validator) *validator.Description {
+	d := validator.Describe(v)
+	if d == nil {
+		if elemType, ok := validator.ValuerElemType(typ); ok {
+			typ = elemType
+		}
+		return &validator.Description{Type: kindName(typ)}
+	}
+	refNamedStruct(typ, d)
+	return d
+}
+
+func refNamedStruct(typ typesutil.Type, d *validator.Description) {
+	if d == nil {
+		return
+	}
+
+	typ = typesutil.Deref(typ)
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		if d.Type == validator.DescriptionTypeObject && typ.Name() != "" {
+			d.Ref = typ.Name()
+		}
+	case reflect.Slice, reflect.Array:
+		refNamedStruct(typ.Elem(), d.Elem)
+	case reflect.Map:
+		refNamedStruct(typ.Key(), d.Key)
+		refNamedStruct(typ.Elem(), d.Elem)
+	}
+}
+
+func kindName(typ typesutil.Type) string {
+	if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); ok {
+		return validator.DescriptionTypeString
+	}
+
+	switch typesutil.Deref(typ).Kind() {
+	case reflect.String:
+		return validator.DescriptionTypeString
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return validator.DescriptionTypeInteger
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return validator.DescriptionTypeUint
+	case reflect.Float32, reflect.Float64:
+		return validator.DescriptionTypeNumber
+	case reflect.Slice, reflect.Array:
+		return validator.DescriptionTypeList
+	case reflect.Map:
+		return validator.DescriptionTypeMap
+	case reflect.Struct:
+		return validator.DescriptionTypeObject
+	}
+	return "any"
+}
+
+// eachNamedStruct walks elements of slice and map
+func eachNamedStruct(typ typesutil.Type, v validator.Validator, each func(typ typesutil.Type, structValidator *validator.StructValidator)) {
+	typ = typesutil.Deref(typ)
+
+	switch x := v.(type) {
+	case *validator.SliceValidator:
+		walkNamedStruct(typ.Elem(), x.ElemValidator, each)
+	case *validator.MapValidator:
+		walkNamedStruct(typ.Elem(), x.ElemValidator, each)
+	}
+}
+
+func walkNamedStruct(typ typesutil.Type, v validator.Validator, each func(typ typesutil.Type, structValidator *validator.StructValidator)) {
+	v = validator.UnwrapValidatorLoader(v)
+	if structValidator, ok := v.(*validator.StructValidator); ok {
+		if t := typesutil.Deref(typ); t.Name() != "" {
+			each(t, structValidator)
+		}
+		return
+	}
+	eachNamedStruct(typ, v, each)
+}
+
+func (g *Generator) WriteMarkdown(w io.Writer) error {
+	b := &strings.Builder{}
+
+	for i, t := range g.Tables {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("### " + t.Name + "\n\n")
+		b.WriteString("| Field | Type | Required | Default | Description |\n")
+		b.WriteString("| --- | --- | --- | --- | --- |\n")
+
+		for _, row := range t.Rows {
+			cells := []string{
+				"`" + row.Field + "`",
+				"`" + row.Type + "`",
+				requirement(row),
+				row.Default,
+				row.Description,
+			}
+			if row.Default != "" {
+				cells[3] = "`" + row.Default + "`"
+			}
+			if row.DefaultProvider != "" {
+				cells[3] = "computed by `" + row.DefaultProvider + "`"
+			}
+			for i := range cells {
+				cells[i] = escapeMarkdown(cells[i])
+			}
+			b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (g *Generator) WriteHTML(w io.Writer) error {
+	b := &strings.Builder{}
+
+	for _, t := range g.Tables {
+		b.WriteString("<h3>" + html.EscapeString(t.Name) + "</h3>\n")
+		b.WriteString("<table>\n")
+		b.WriteString("<thead><tr><th>Field</th><th>Type</th><th>Required</th><th>Default</th><th>Description</th></tr></thead>\n")
+		b.WriteString("<tbody>\n")
+
+		for _, row := range t.Rows {
+			b.WriteString("<tr>")
+			b.WriteString("<td><code>" + html.EscapeString(row.Field) + "</code></td>")
+			b.WriteString("<td><code>" + html.EscapeString(row.Type) + "</code></td>")
+			b.WriteString("<td>" + requirement(row) + "</td>")
+			if row.Default != "" {
+				b.WriteString("<td><code>" + html.EscapeString(row.Default) + "</code></td>")
+			} else if row.DefaultProvider != "" {
+				b.WriteString("<td>computed by <code>" + html.EscapeString(row.DefaultProvider) + "</code></td>")
+			} else {
+				b.WriteString("<td></td>")
+			}
+			b.WriteString("<td>" + html.EscapeString(row.Description) + "</td>")
+			b.WriteString("</tr>\n")
+		}
+
+		b.WriteString("</tbody>\n")
+		b.WriteString("</table>\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func requirement(row *Row) string {
+	if row.Required {
+		if row.Presence {
+			return "required (not null)"
+		}
+		return "required"
+	}
+	return "optional"
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
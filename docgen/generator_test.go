@@ -0,0 +1,175 @@
+package docgen
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Address struct {
+	Zip string `json:"zip" validate:"@string/^[0-9]{6}$|^[A-Z]{2}$/"`
+}
+
+type Contact struct {
+	Email string `json:"email" validate:"@string[3,]"`
+}
+
+type User struct {
+	Name     string             `json:"name" validate:"@char[1,10]"`
+	Role     string             `json:"role,omitempty" validate:"@string{ADMIN,MEMBER}" default:"MEMBER"`
+	Age      int                `json:"age" validate:"@int[18,120)"`
+	Score    float64            `json:"score,omitempty" validate:"@float<5,2>[0,100]"`
+	Active   bool               `json:"active,omitempty"`
+	Tags     []string           `json:"tags" validate:"@slice<@string[1,]>[,3]"`
+	Contacts map[string]Contact `json:"contacts,omitempty" validate:"@map<@string[1,],>[1,]"`
+	Address  Address            `json:"address"`
+	Options  struct {
+		Theme string `json:"theme,omitempty"`
+	} `json:"options"`
+}
+
+func ExampleGenerator_WriteMarkdown() {
+	g := NewGenerator("json")
+	if err := g.Add(reflect.TypeOf(User{})); err != nil {
+		panic(err)
+	}
+	_ = g.WriteMarkdown(os.Stdout)
+	// Output:
+	// ### User
+	//
+	// | Field | Type | Required | Default | Description |
+	// | --- | --- | --- | --- | --- |
+	// | `name` | `string` | required |  | string with 1–10 characters (rune count) |
+	// | `role` | `string` | optional | `MEMBER` | string one of ADMIN, MEMBER |
+	// | `age` | `int` | required |  | integer between 18 (inclusive) and 120 (exclusive) |
+	// | `score` | `float64` | optional |  | number with up to 5 digits and 2 decimal places, between 0 and 100 inclusive |
+	// | `active` | `bool` | optional |  | boolean |
+	// | `tags` | `[]string` | required |  | list with at most 3 items, each (string with at least 1 byte) |
+	// | `contacts` | `map[string]docgen.Contact` | optional |  | map with at least 1 entry, keys (string with at least 1 byte), values (object, see Contact) |
+	// | `address` | `docgen.Address` | required |  | object, see Address |
+	// | `options` | `struct { Theme string "json:\"theme,omitempty\"" }` | required |  | object |
+	// | `options.theme` | `string` | optional |  | string |
+	//
+	// ### Contact
+	//
+	// | Field | Type | Required | Default | Description |
+	// | --- | --- | --- | --- | --- |
+	// | `email` | `string` | required |  | string with at least 3 bytes |
+	//
+	// ### Address
+	//
+	// | Field | Type | Required | Default | Description |
+	// | --- | --- | --- | --- | --- |
+	// | `zip` | `string` | required |  | string matching /^[0-9]{6}$\|^[A-Z]{2}$/ |
+}
+
+func TestGenerator(t *testing.T) {
+	t.Run("html", func(t *testing.T) {
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(&Address{})))
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, g.WriteHTML(buf))
+		require.Equal(t, `<h3>Address</h3>
+<table>
+<thead><tr><th>Field</th><th>Type</th><th>Required</th><th>Default</th><th>Description</th></tr></thead>
+<tbody>
+<tr><td><code>zip</code></td><td><code>string</code></td><td>required</td><td></td><td>string matching /^[0-9]{6}$|^[A-Z]{2}$/</td></tr>
+</tbody>
+</table>
+`, buf.String())
+	})
+
+	t.Run("documented once", func(t *testing.T) {
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(User{})))
+		require.NoError(t, g.Add(reflect.TypeOf(Address{})))
+		require.Len(t, g.Tables, 3)
+	})
+
+	t.Run("not named struct", func(t *testing.T) {
+		require.Error(t, NewGenerator("json").Add(reflect.TypeOf(struct{}{})))
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		type Counter struct {
+			Count int `json:"count" validate:"@int[0,10]!"`
+			Size  int `json:"size" validate:"@int[0,10]"`
+			Step  int `json:"step,omitempty" validate:"@int[0,10]!"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Counter{})))
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, g.WriteMarkdown(buf))
+		require.Contains(t, buf.String(), "| `count` | `int` | required (not null) |  | integer between 0 and 10 inclusive |\n")
+		require.Contains(t, buf.String(), "| `size` | `int` | required |  | integer between 0 and 10 inclusive |\n")
+		require.Contains(t, buf.String(), "| `step` | `int` | optional |  | integer between 0 and 10 inclusive |\n")
+	})
+
+	t.Run("provided defaults", func(t *testing.T) {
+		type Event struct {
+			At    string `json:"at,omitempty" default:"$now"`
+			Price string `json:"price,omitempty" default:"$$5"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Event{})))
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, g.WriteMarkdown(buf))
+		require.Contains(t, buf.String(), "| `at` | `string` | optional | computed by `now` | string |\n")
+		require.Contains(t, buf.String(), "| `price` | `string` | optional | `$5` | string |\n")
+
+		buf.Reset()
+		require.NoError(t, g.WriteHTML(buf))
+		require.Contains(t, buf.String(), "<td>computed by <code>now</code></td>")
+	})
+
+	t.Run("sql null types", func(t *testing.T) {
+		type Profile struct {
+			Name sql.NullString `json:"name" validate:"@string[1,10]"`
+			Nick sql.NullString `json:"nick,omitempty" validate:"@string[1,10]"`
+			At   sql.NullTime   `json:"at,omitempty"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Profile{})))
+
+		buf := bytes.NewBuffer(nil)
+		require.NoError(t, g.WriteMarkdown(buf))
+		require.Contains(t, buf.String(), "| `name` | `sql.NullString` | required (not null) |  | string with 1–10 bytes |\n")
+		require.Contains(t, buf.String(), "| `nick` | `sql.NullString` | optional |  | string with 1–10 bytes |\n")
+		require.Contains(t, buf.String(), "| `at` | `sql.NullTime` | optional |  | string |\n")
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		type Ranges struct {
+			Exactly map[string]string `json:"exactly" validate:"@map<,>[10,10]"`
+			AtMost  map[string]string `json:"atMost" validate:"@map<,>[,10]"`
+			Any     map[string]string `json:"any"`
+			Greater int               `json:"greater" validate:"@int(1,]"`
+			Less    int               `json:"less" validate:"@int[,2]"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Ranges{})))
+
+		descriptions := make([]string, 0)
+		for _, row := range g.Tables[0].Rows {
+			descriptions = append(descriptions, row.Description)
+		}
+		require.Equal(t, []string{
+			"map with exactly 10 entries",
+			"map with at most 10 entries",
+			"map",
+			"integer greater than 1",
+			"integer at most 2",
+		}, descriptions)
+	})
+}
@@ -0,0 +1,233 @@
+package envvalidate
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"go/ast"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+const TagEnv = "env"
+
+type LookupFunc = func(key string) (string, bool)
+
+// LookupMap creates LookupFunc from map, useful for tests
+func LookupMap(m map[string]string) LookupFunc {
+	return func(key string) (string, bool) {
+		v, ok := m[key]
+		return v, ok
+	}
+}
+
+// Load fills struct which ptr points to from environment variables and validates it
+func Load(ptr interface{}) error {
+	return NewLoader(os.LookupEnv).Load(ptr)
+}
+
+func NewLoader(lookup LookupFunc) *Loader {
+	return &Loader{
+		Lookup: lookup,
+	}
+}
+
+/*
+Loader fills struct from environment variables by tag `env`, and validates it.
+
+	type Config struct {
+		DB struct {
+			Host string `env:"HOST" validate:"@hostname"`
+			Port int    `env:"PORT" validate:"@int[1,65535]" default:"5432"`
+		} `env:"DB"`
+		Tags    []string      `env:"TAGS"` // values separated by comma
+		Timeout time.Duration `env:"TIMEOUT"` // parsed by time.ParseDuration
+	}
+
+Fields of nested struct will be named with prefix of the struct field, like DB_HOST and DB_PORT,
+errors will use the env names as key path, like `DB_PORT should be larger than ...`.
+Nil pointers of nested struct will be allocated only when any env of its fields exists.
+*/
+type Loader struct {
+	Lookup LookupFunc
+	// prefix for all env names
+	Prefix string
+}
+
+func (loader *Loader) Load(ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("env config should be pointer of struct, but got %T", ptr)
+	}
+	rv = rv.Elem()
+
+	ctx := validator.ContextWithNamedTagKey(context.Background(), TagEnv)
+
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(rv.Type()))
+	if err != nil {
+		return err
+	}
+
+	errSet := errors.NewErrorSet("")
+	failed := make([]errors.KeyPath, 0)
+
+	loader.fill(rv, loader.Prefix, func(key string, err error) {
+		failed = append(failed, errors.KeyPath{key})
+		errSet.AddErr(err, key)
+	})
+
+	errSet.Merge(v.Validate(rv), func(keyPath errors.KeyPath) errors.KeyPath {
+		if len(keyPath) == 0 {
+			return keyPath
+		}
+		return errors.KeyPath{loader.Prefix + envName(keyPath)}
+	}, failed...)
+
+	return errSet.Err()
+}
+
+func (loader *Loader) fill(rv reflect.Value, prefix string, onErr func(key string, err error)) {
+	typ := rv.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, _, exists := typesutil.FieldDisplayName(field.Tag, TagEnv, field.Name)
+		if !ast.IsExported(field.Name) || name == "-" {
+			continue
+		}
+
+		fieldValue := rv.Field(i)
+
+		if isNestedStruct(field.Type) {
+			nestedPrefix := prefix + name + "_"
+			if field.Anonymous && !exists {
+				nestedPrefix = prefix
+			}
+
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					// nil struct pointer will be kept when no env of it, so optional one could be absent
+					if !loader.hasEnv(field.Type.Elem(), nestedPrefix, map[reflect.Type]bool{}) {
+						continue
+					}
+					fieldValue.Set(reflect.New(field.Type.Elem()))
+				}
+				fieldValue = fieldValue.Elem()
+			}
+
+			loader.fill(fieldValue, nestedPrefix, onErr)
+			continue
+		}
+
+		key := prefix + name
+
+		value, ok := loader.Lookup(key)
+		if !ok {
+			defaultValue, hasDefault := field.Tag.Lookup(validator.TagDefault)
+			if !hasDefault {
+				continue
+			}
+			value = defaultValue
+		}
+
+		if err := unmarshalText(fieldValue, value); err != nil {
+			onErr(key, err)
+		}
+	}
+}
+
+// hasEnv returns true when any env of fields of typ with prefix exists
+func (loader *Loader) hasEnv(typ reflect.Type, prefix string, visiting map[reflect.Type]bool) bool {
+	// recursive struct types
+	if visiting[typ] {
+		return false
+	}
+	visiting[typ] = true
+	defer delete(visiting, typ)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, _, exists := typesutil.FieldDisplayName(field.Tag, TagEnv, field.Name)
+		if !ast.IsExported(field.Name) || name == "-" {
+			continue
+		}
+
+		if isNestedStruct(field.Type) {
+			nestedPrefix := prefix + name + "_"
+			if field.Anonymous && !exists {
+				nestedPrefix = prefix
+			}
+			if loader.hasEnv(reflectx.Deref(field.Type), nestedPrefix, visiting) {
+				return true
+			}
+			continue
+		}
+
+		if _, ok := loader.Lookup(prefix + name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func unmarshalText(rv reflect.Value, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if rv.Type() == typDuration {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(int64(d))
+		return nil
+	}
+
+	if rv.Kind() == reflect.Slice && !reflectx.IsBytes(rv.Type()) && !isTextUnmarshaler(rv.Type()) {
+		values := strings.Split(value, ",")
+		list := reflect.MakeSlice(rv.Type(), len(values), len(values))
+		for i := range values {
+			if err := reflectx.UnmarshalText(list.Index(i), []byte(strings.TrimSpace(values[i]))); err != nil {
+				return err
+			}
+		}
+		rv.Set(list)
+		return nil
+	}
+
+	return reflectx.UnmarshalText(rv, []byte(value))
+}
+
+var typDuration = reflect.TypeOf(time.Duration(0))
+
+var typTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+func isTextUnmarshaler(typ reflect.Type) bool {
+	return reflect.PtrTo(typ).Implements(typTextUnmarshaler) || typ.Implements(typTextUnmarshaler)
+}
+
+func isNestedStruct(typ reflect.Type) bool {
+	return reflectx.Deref(typ).Kind() == reflect.Struct && !isTextUnmarshaler(reflectx.Deref(typ))
+}
+
+func envName(keyPath errors.KeyPath) string {
+	b := &strings.Builder{}
+	for _, keyOrIndex := range keyPath {
+		if b.Len() > 0 {
+			b.WriteRune('_')
+		}
+		_, _ = fmt.Fprintf(b, "%v", keyOrIndex)
+	}
+	return b.String()
+}
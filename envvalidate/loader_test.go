@@ -0,0 +1,101 @@
+package envvalidate
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Database struct {
+	Host string `env:"HOST" validate:"@string[1,]"`
+	Port int    `env:"PORT" validate:"@int[1024,65535]" default:"5432"`
+}
+
+type Log struct {
+	Level string `env:"LOG_LEVEL" validate:"@string{debug}" default:"debug"`
+}
+
+type Config struct {
+	DB      Database      `env:"DB"`
+	Replica *Database     `env:"REPLICA,omitempty"`
+	Tags    []string      `env:"TAGS" validate:"@slice<@string[1,]>[1,]"`
+	Timeout time.Duration `env:"TIMEOUT" validate:"@int64[0,]?"`
+	Log
+}
+
+func ExampleLoader() {
+	loader := NewLoader(LookupMap(map[string]string{
+		"APP_DB_HOST":      "localhost",
+		"APP_DB_PORT":      "80",
+		"APP_REPLICA_HOST": "replica",
+		"APP_REPLICA_PORT": "port",
+		"APP_TAGS":         "a,b",
+	}))
+	loader.Prefix = "APP_"
+
+	c := &Config{}
+	fmt.Println(loader.Load(c))
+	fmt.Println(c.DB.Host, c.Tags, c.Level)
+	// Output:
+	// APP_REPLICA_PORT cannot set value `port`: strconv.ParseInt: parsing "port": invalid syntax
+	// APP_DB_PORT int value should be larger or equal than 1024 and less or equal than 65535, but got invalid value 80
+	//
+	// localhost [a b] debug
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("from os env", func(t *testing.T) {
+		_ = os.Setenv("DB_HOST", "localhost")
+		_ = os.Setenv("REPLICA_HOST", "replica")
+		_ = os.Setenv("TAGS", "a")
+		_ = os.Setenv("TIMEOUT", "1s")
+		defer func() {
+			_ = os.Unsetenv("DB_HOST")
+			_ = os.Unsetenv("REPLICA_HOST")
+			_ = os.Unsetenv("TAGS")
+			_ = os.Unsetenv("TIMEOUT")
+		}()
+
+		c := &Config{}
+		require.NoError(t, Load(c))
+		require.Equal(t, 5432, c.DB.Port)
+		require.Equal(t, 5432, c.Replica.Port)
+		require.Equal(t, time.Second, c.Timeout)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		c := &Config{}
+		err := NewLoader(LookupMap(map[string]string{})).Load(c)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "DB_HOST missing required field")
+		require.NotContains(t, err.Error(), "REPLICA")
+		require.Contains(t, err.Error(), "TAGS missing required field")
+		require.Nil(t, c.Replica)
+	})
+
+	t.Run("optional nested struct", func(t *testing.T) {
+		env := map[string]string{
+			"DB_HOST": "localhost",
+			"TAGS":    "a",
+		}
+
+		c := &Config{}
+		require.NoError(t, NewLoader(LookupMap(env)).Load(c))
+		require.Nil(t, c.Replica)
+
+		env["REPLICA_PORT"] = "6432"
+
+		c = &Config{}
+		err := NewLoader(LookupMap(env)).Load(c)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "REPLICA_HOST missing required field")
+		require.Equal(t, 6432, c.Replica.Port)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		require.Error(t, Load(Config{}))
+	})
+}
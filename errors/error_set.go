@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"container/list"
 	"fmt"
+	"strings"
 )
 
 func NewErrorSet(root string) *ErrorSet {
@@ -22,12 +23,22 @@ func (errorSet *ErrorSet) AddErr(err error, keyPathNodes ...interface{}) {
 	if err == nil {
 		return
 	}
-	errorSet.errors.PushBack(&FieldError{
-		Field: KeyPath(keyPathNodes),
-		Error: err,
+	severity := SeverityError
+	if warning, ok := err.(*WarningError); ok {
+		severity = SeverityWarning
+		err = warning.Err
+	}
+	errorSet.addFieldErr(&FieldError{
+		Field:    KeyPath(keyPathNodes),
+		Error:    err,
+		Severity: severity,
 	})
 }
 
+func (errorSet *ErrorSet) addFieldErr(fieldErr *FieldError) {
+	errorSet.errors.PushBack(fieldErr)
+}
+
 func (errorSet *ErrorSet) Each(cb func(fieldErr *FieldError)) {
 	l := errorSet.errors
 	for e := l.Front(); e != nil; e = e.Next() {
@@ -43,20 +54,83 @@ func (errorSet *ErrorSet) Flatten() *ErrorSet {
 	errorSet.Each(func(fieldErr *FieldError) {
 		if subSet, ok := fieldErr.Error.(*ErrorSet); ok {
 			subSet.Flatten().Each(func(subSetFieldErr *FieldError) {
-				set.AddErr(subSetFieldErr.Error, append(fieldErr.Field, subSetFieldErr.Field...)...)
+				severity := subSetFieldErr.Severity
+				// all errors under a warning should be warnings
+				if fieldErr.Severity > severity {
+					severity = fieldErr.Severity
+				}
+				set.addFieldErr(&FieldError{
+					Field:    append(append(KeyPath{}, fieldErr.Field...), subSetFieldErr.Field...),
+					Error:    subSetFieldErr.Error,
+					Severity: severity,
+				})
 			})
 		} else {
-			set.AddErr(fieldErr.Error, fieldErr.Field...)
+			set.addFieldErr(fieldErr)
+		}
+	})
+
+	return set
+}
+
+// Filter returns flattened error set which only contains errors of the severity
+func (errorSet *ErrorSet) Filter(severity Severity) *ErrorSet {
+	set := NewErrorSet(errorSet.root)
+
+	errorSet.Flatten().Each(func(fieldErr *FieldError) {
+		if fieldErr.Severity == severity {
+			set.addFieldErr(fieldErr)
 		}
 	})
 
 	return set
 }
 
+// Errors returns errors which should fail the validation
+func (errorSet *ErrorSet) Errors() *ErrorSet {
+	return errorSet.Filter(SeverityError)
+}
+
+// Warnings returns advisory errors which should not fail the validation
+func (errorSet *ErrorSet) Warnings() *ErrorSet {
+	return errorSet.Filter(SeverityWarning)
+}
+
 func (errorSet *ErrorSet) Len() int {
 	return errorSet.Flatten().errors.Len()
 }
 
+// Merge adds flattened errors of err into errorSet with their severities.
+// Key paths of errors will be mapped by mapKeyPath when it is not nil.
+// Errors under any of skipped key paths will be dropped,
+// which are useful to skip rule errors of values failed to decode and already reported.
+func (errorSet *ErrorSet) Merge(err error, mapKeyPath func(keyPath KeyPath) KeyPath, skipped ...KeyPath) {
+	if err == nil {
+		return
+	}
+
+	set, ok := err.(*ErrorSet)
+	if !ok {
+		set = NewErrorSet("")
+		set.AddErr(err)
+	}
+
+	set.Flatten().Each(func(fieldErr *FieldError) {
+		keyPath := fieldErr.Field
+		if mapKeyPath != nil {
+			keyPath = mapKeyPath(keyPath)
+		}
+		if keyPath.isUnderAny(skipped) {
+			return
+		}
+		errorSet.addFieldErr(&FieldError{
+			Field:    keyPath,
+			Error:    fieldErr.Error,
+			Severity: fieldErr.Severity,
+		})
+	})
+}
+
 func (errorSet *ErrorSet) Err() error {
 	if errorSet.errors.Len() == 0 {
 		return nil
@@ -70,6 +144,9 @@ func (errorSet *ErrorSet) Error() string {
 	buf := bytes.Buffer{}
 	set.Each(func(fieldErr *FieldError) {
 		buf.WriteString(fmt.Sprintf("%s %s", fieldErr.Field, fieldErr.Error))
+		if fieldErr.Severity == SeverityWarning {
+			buf.WriteString(" (warning)")
+		}
 		buf.WriteRune('\n')
 	})
 
@@ -77,8 +154,9 @@ func (errorSet *ErrorSet) Error() string {
 }
 
 type FieldError struct {
-	Field KeyPath
-	Error error `json:"msg"`
+	Field    KeyPath
+	Error    error `json:"msg"`
+	Severity Severity
 }
 
 type KeyPath []interface{}
@@ -98,3 +176,21 @@ func (keyPath KeyPath) String() string {
 	}
 	return buf.String()
 }
+
+// isUnderAny returns true when keyPath is the same one of or nested under any of keyPaths
+func (keyPath KeyPath) isUnderAny(keyPaths []KeyPath) bool {
+	if len(keyPaths) == 0 {
+		return false
+	}
+
+	s := keyPath.String()
+
+	for _, p := range keyPaths {
+		parent := p.String()
+		if parent == "" || s == parent || strings.HasPrefix(s, parent+".") || strings.HasPrefix(s, parent+"[") {
+			return true
+		}
+	}
+
+	return false
+}
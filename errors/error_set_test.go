@@ -22,3 +22,24 @@ func ExampleErrorSet() {
 	// Key[1].PropA err
 	// Key[1].PropB err
 }
+
+func ExampleErrorSet_Merge() {
+	validateErrSet := NewErrorSet("")
+	validateErrSet.AddErr(fmt.Errorf("missing required field"), "items", 0, "count")
+	validateErrSet.AddErr(fmt.Errorf("out of range"), "items", 1, "count")
+	validateErrSet.AddErr(NewWarning(fmt.Errorf("too long")), "name")
+
+	errSet := NewErrorSet("")
+	// failed to decode before validating
+	errSet.AddErr(fmt.Errorf("invalid syntax"), "items", 0)
+
+	errSet.Merge(validateErrSet, func(keyPath KeyPath) KeyPath {
+		return append(KeyPath{"body"}, keyPath...)
+	}, KeyPath{"body", "items", 0})
+
+	fmt.Println(errSet)
+	// Output:
+	// items[0] invalid syntax
+	// body.items[1].count out of range
+	// body.name too long (warning)
+}
@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"net/http"
+)
+
+const ContentTypeProblemJSON = "application/problem+json"
+
+// codes of error kinds
+const (
+	CodeMissingRequired = "missing_required"
+	CodeOutOfRange      = "out_of_range"
+	CodeNotMatch        = "not_match"
+	CodeNotInEnum       = "not_in_enum"
+	CodeMultipleOf      = "multiple_of"
+	CodeUnsupportedType = "unsupported_type"
+	CodeSyntax          = "syntax"
+	CodeTypeMismatch    = "type_mismatch"
+	CodeUnknownField    = "unknown_field"
+	CodeMaxDepth        = "max_depth"
+	CodeInvalid         = "invalid"
+)
+
+// ErrorCoder could be implemented by custom errors to declare the code of error kind
+type ErrorCoder interface {
+	Code() string
+}
+
+// ErrorCode returns code of error kind
+func ErrorCode(err error) string {
+	switch e := err.(type) {
+	case ErrorCoder:
+		return e.Code()
+	case *WarningError:
+		return ErrorCode(e.Err)
+	case MissingRequiredFieldError, *MissingRequiredFieldError:
+		return CodeMissingRequired
+	case *OutOfRangeError:
+		return CodeOutOfRange
+	case *NotMatchError:
+		return CodeNotMatch
+	case *NotInEnumError:
+		return CodeNotInEnum
+	case *MultipleOfError:
+		return CodeMultipleOf
+	case *UnsupportedTypeError:
+		return CodeUnsupportedType
+	case *SyntaxError:
+		return CodeSyntax
+	case *TypeMismatchError:
+		return CodeTypeMismatch
+	case UnknownFieldError, *UnknownFieldError:
+		return CodeUnknownField
+	case *MaxDepthExceededError:
+		return CodeMaxDepth
+	}
+	return CodeInvalid
+}
+
+// ProblemDetails of RFC 7807 https://tools.ietf.org/html/rfc7807
+type ProblemDetails struct {
+	Type          string          `json:"type"`
+	Title         string          `json:"title"`
+	Status        int             `json:"status"`
+	Detail        string          `json:"detail,omitempty"`
+	Instance      string          `json:"instance,omitempty"`
+	InvalidParams []*InvalidParam `json:"invalid-params,omitempty"`
+}
+
+type InvalidParam struct {
+	// type URI of error kind, empty when ProblemOptions.TypeOfCode not set
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Code   string `json:"code"`
+}
+
+type ProblemOptions struct {
+	// type URI of the problem, default about:blank
+	Type string
+	// default http.StatusText of Status
+	Title string
+	// default 400
+	Status int
+	// default `validation failed`
+	Detail   string
+	Instance string
+	// resolves type URI of each invalid param by code of error kind
+	TypeOfCode func(code string) string
+}
+
+// DefaultProblemOptions will be used when nil options passed,
+// could be replaced to make every service report validation errors identically.
+var DefaultProblemOptions = &ProblemOptions{}
+
+// ProblemDetails converts errors of the set to RFC 7807 problem details with `invalid-params` extension,
+// warnings will be ignored.
+func (errorSet *ErrorSet) ProblemDetails(opts *ProblemOptions) *ProblemDetails {
+	if opts == nil {
+		opts = DefaultProblemOptions
+	}
+
+	problem := &ProblemDetails{
+		Type:     opts.Type,
+		Title:    opts.Title,
+		Status:   opts.Status,
+		Detail:   opts.Detail,
+		Instance: opts.Instance,
+	}
+
+	if problem.Type == "" {
+		problem.Type = "about:blank"
+	}
+	if problem.Status == 0 {
+		problem.Status = http.StatusBadRequest
+	}
+	if problem.Title == "" {
+		problem.Title = http.StatusText(problem.Status)
+	}
+	if problem.Detail == "" {
+		problem.Detail = "validation failed"
+	}
+
+	errorSet.Errors().Each(func(fieldErr *FieldError) {
+		param := &InvalidParam{
+			Name:   fieldErr.Field.String(),
+			Reason: fieldErr.Error.Error(),
+			Code:   ErrorCode(fieldErr.Error),
+		}
+		if opts.TypeOfCode != nil {
+			param.Type = opts.TypeOfCode(param.Code)
+		}
+		problem.InvalidParams = append(problem.InvalidParams, param)
+	})
+
+	return problem
+}
@@ -0,0 +1,83 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+type customError struct{}
+
+func (customError) Error() string {
+	return "custom"
+}
+
+func (customError) Code() string {
+	return "custom"
+}
+
+func ExampleErrorSet_ProblemDetails() {
+	errSet := NewErrorSet("")
+	errSet.AddErr(MissingRequiredFieldError{}, "name")
+	errSet.AddErr(&NotMatchError{Target: "string", Current: "x", Pattern: regexp.MustCompile(`\d+`)}, "code")
+	errSet.AddErr(&OutOfRangeError{Target: "int value", Current: 11, Maximum: 10}, "items", 1, "count")
+	errSet.AddErr(NewWarning(fmt.Errorf("deprecated")), "legacy")
+	errSet.AddErr(customError{}, "other")
+
+	problem := errSet.ProblemDetails(&ProblemOptions{
+		Type:     "https://errors.example.com/validation",
+		Instance: "/users",
+		TypeOfCode: func(code string) string {
+			return "https://errors.example.com/validation/" + code
+		},
+	})
+
+	e := json.NewEncoder(os.Stdout)
+	e.SetIndent("", "  ")
+	_ = e.Encode(problem)
+	// Output:
+	// {
+	//   "type": "https://errors.example.com/validation",
+	//   "title": "Bad Request",
+	//   "status": 400,
+	//   "detail": "validation failed",
+	//   "instance": "/users",
+	//   "invalid-params": [
+	//     {
+	//       "type": "https://errors.example.com/validation/missing_required",
+	//       "name": "name",
+	//       "reason": "missing required field",
+	//       "code": "missing_required"
+	//     },
+	//     {
+	//       "type": "https://errors.example.com/validation/not_match",
+	//       "name": "code",
+	//       "reason": "string \\d+ not match x",
+	//       "code": "not_match"
+	//     },
+	//     {
+	//       "type": "https://errors.example.com/validation/out_of_range",
+	//       "name": "items[1].count",
+	//       "reason": "int value should be less or equal than 10, but got invalid value 11",
+	//       "code": "out_of_range"
+	//     },
+	//     {
+	//       "type": "https://errors.example.com/validation/custom",
+	//       "name": "other",
+	//       "reason": "custom",
+	//       "code": "custom"
+	//     }
+	//   ]
+	// }
+}
+
+func ExampleErrorCode() {
+	fmt.Println(ErrorCode(&MultipleOfError{}))
+	fmt.Println(ErrorCode(NewWarning(&NotInEnumError{})))
+	fmt.Println(ErrorCode(fmt.Errorf("some error")))
+	// Output:
+	// multiple_of
+	// not_in_enum
+	// invalid
+}
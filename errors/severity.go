@@ -0,0 +1,73 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "error", "":
+		return SeverityError, nil
+	case "warn", "warning":
+		return SeverityWarning, nil
+	default:
+		return SeverityError, fmt.Errorf("unsupported severity `%s`", s)
+	}
+}
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// NewWarning marks err as advisory,
+// ErrorSet will record it with SeverityWarning
+func NewWarning(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*WarningError); ok {
+		return err
+	}
+	return &WarningError{Err: err}
+}
+
+type WarningError struct {
+	Err error
+}
+
+func (e *WarningError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *WarningError) Unwrap() error {
+	return e.Err
+}
+
+// Split splits err into errors which should fail the validation and warnings which should not
+func Split(err error) (error, *ErrorSet) {
+	switch e := err.(type) {
+	case nil:
+		return nil, NewErrorSet("")
+	case *WarningError:
+		warnings := NewErrorSet("")
+		warnings.AddErr(e)
+		return nil, warnings.Flatten()
+	case *ErrorSet:
+		return e.Errors().Err(), e.Warnings()
+	default:
+		return err, NewErrorSet("")
+	}
+}
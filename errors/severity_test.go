@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"fmt"
+)
+
+func ExampleSplit() {
+	subErrSet := NewErrorSet("")
+	subErrSet.AddErr(fmt.Errorf("err"), "PropA")
+	subErrSet.AddErr(NewWarning(fmt.Errorf("deprecated")), "PropB")
+
+	errSet := NewErrorSet("")
+	errSet.AddErr(NewWarning(fmt.Errorf("too long")), "Key")
+	errSet.AddErr(subErrSet.Err(), "Key", 1)
+	errSet.AddErr(NewWarning(subErrSet.Err()), "Key", 2)
+
+	fmt.Println(errSet)
+
+	err, warnings := Split(errSet)
+	fmt.Println(err)
+	fmt.Println(warnings.Len())
+	// Output:
+	// Key too long (warning)
+	// Key[1].PropA err
+	// Key[1].PropB deprecated (warning)
+	// Key[2].PropA err (warning)
+	// Key[2].PropB deprecated (warning)
+	//
+	// Key[1].PropA err
+	//
+	// 4
+}
+
+func ExampleParseSeverity() {
+	fmt.Println(ParseSeverity("warn"))
+	fmt.Println(ParseSeverity(""))
+	fmt.Println(ParseSeverity("fatal"))
+	// Output:
+	// warning <nil>
+	// error <nil>
+	// error unsupported severity `fatal`
+}
@@ -77,7 +77,7 @@ func (e *OutOfRangeError) Error() string {
 
 	if e.Minimum != nil {
 		buf.WriteString(" larger")
-		if e.ExclusiveMinimum {
+		if !e.ExclusiveMinimum {
 			buf.WriteString(" or equal")
 		}
 
@@ -90,7 +90,7 @@ func (e *OutOfRangeError) Error() string {
 		}
 
 		buf.WriteString(" less")
-		if e.ExclusiveMaximum {
+		if !e.ExclusiveMaximum {
 			buf.WriteString(" or equal")
 		}
 
@@ -101,3 +101,43 @@ func (e *OutOfRangeError) Error() string {
 
 	return buf.String()
 }
+
+type TypeMismatchError struct {
+	Expect  string
+	Current interface{}
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("value should be %s, but got %s", e.Expect, describeValue(e.Current))
+}
+
+func describeValue(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case bool:
+		return fmt.Sprintf("boolean %v", v)
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	}
+	return fmt.Sprintf("%T %v", v, v)
+}
+
+type UnknownFieldError struct{}
+
+func (UnknownFieldError) Error() string {
+	return "unknown field"
+}
+
+// MaxDepthExceededError is returned when nested values of recursive types are too deep
+type MaxDepthExceededError struct {
+	MaxDepth int
+}
+
+func (e *MaxDepthExceededError) Error() string {
+	return fmt.Sprintf("value nested too deep, max depth is %d", e.MaxDepth)
+}
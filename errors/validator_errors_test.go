@@ -53,5 +53,31 @@ func ExampleOutOfRangeError() {
 		ExclusiveMaximum: true,
 	})
 	// Output:
-	// int value should be larger or equal than 1 and less or equal than 10, but got invalid value 11
+	// int value should be larger than 1 and less than 10, but got invalid value 11
+}
+
+func ExampleTypeMismatchError() {
+	fmt.Println(&TypeMismatchError{
+		Expect:  "int",
+		Current: "1",
+	})
+	fmt.Println(&TypeMismatchError{
+		Expect:  "string",
+		Current: 1.1,
+	})
+	// Output:
+	// value should be int, but got string "1"
+	// value should be string, but got float64 1.1
+}
+
+func ExampleUnknownFieldError() {
+	fmt.Println(UnknownFieldError{})
+	// Output:
+	// unknown field
+}
+
+func ExampleMaxDepthExceededError() {
+	fmt.Println(&MaxDepthExceededError{MaxDepth: 32})
+	// Output:
+	// value nested too deep, max depth is 32
 }
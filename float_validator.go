@@ -104,8 +104,11 @@ func (validator *FloatValidator) Validate(v interface{}) error {
 		return errors.NewUnsupportedTypeError(rv.Type().String(), validator.String())
 	}
 
-	val := rv.Float()
+	return validator.validateFloat(rv.Float())
+}
 
+// validateFloat validates float value without reflect
+func (validator *FloatValidator) validateFloat(val float64) error {
 	decimalDigits := *validator.DecimalDigits
 
 	m, d := lengthOfDigits(val)
@@ -134,7 +137,7 @@ func (validator *FloatValidator) Validate(v interface{}) error {
 
 			return &errors.NotInEnumError{
 				Target:  TargetFloatValue,
-				Current: v,
+				Current: val,
 				Enums:   values,
 			}
 		}
@@ -452,3 +455,38 @@ func (validator *FloatValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *FloatValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeNumber}
+
+	if validator.DecimalDigits != nil {
+		d.add(&Constraint{
+			Code:   errors.CodeOutOfRange,
+			Target: TargetTotalDigitsOfFloatValue,
+			Params: map[string]interface{}{
+				"maxDigits":     validator.MaxDigits,
+				"decimalDigits": *validator.DecimalDigits,
+			},
+		})
+	}
+
+	if validator.Enums != nil {
+		return d.add(enumConstraint(TargetFloatValue, validator.Enums))
+	}
+
+	var minimum, maximum interface{}
+	if validator.Minimum != nil {
+		minimum = *validator.Minimum
+	}
+	if validator.Maximum != nil {
+		maximum = *validator.Maximum
+	}
+
+	d.add(rangeConstraint(TargetFloatValue, minimum, maximum, validator.ExclusiveMinimum, validator.ExclusiveMaximum))
+
+	if validator.MultipleOf != 0 {
+		d.add(multipleOfConstraint(TargetFloatValue, validator.MultipleOf))
+	}
+
+	return d
+}
@@ -1,6 +1,6 @@
 module github.com/go-courier/validator
 
-go 1.16
+go 1.18
 
 require (
 	github.com/davecgh/go-spew v1.1.1
@@ -8,3 +8,8 @@ require (
 	github.com/go-courier/reflectx v1.3.4
 	github.com/stretchr/testify v1.4.0
 )
+
+require (
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v2 v2.2.4 // indirect
+)
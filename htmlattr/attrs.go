@@ -0,0 +1,223 @@
+package htmlattr
+
+import (
+	"context"
+	"html"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+)
+
+// Attr is attribute of HTML input, boolean attribute has empty Value
+type Attr struct {
+	Name  string
+	Value string
+}
+
+type Attrs []Attr
+
+func (attrs Attrs) Get(name string) (string, bool) {
+	for _, attr := range attrs {
+		if attr.Name == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// String renders attributes, values will be escaped.
+//
+//	required minlength="1" maxlength="10"
+func (attrs Attrs) String() string {
+	b := &strings.Builder{}
+	for i, attr := range attrs {
+		if i > 0 {
+			b.WriteString(" ")
+		}
+		b.WriteString(attr.Name)
+		if attr.Value != "" {
+			b.WriteString(`="`)
+			b.WriteString(html.EscapeString(attr.Value))
+			b.WriteString(`"`)
+		}
+	}
+	return b.String()
+}
+
+func (attrs *Attrs) add(name string, value string) {
+	*attrs = append(*attrs, Attr{Name: name, Value: value})
+}
+
+type Field struct {
+	// display name of field, names of nested struct fields joined by "."
+	Name  string
+	Attrs Attrs
+	// values for <option> when enums defined, sorted by enum values
+	Options []string
+}
+
+// FromType compiles struct type and returns attributes of each field
+func FromType(typ reflect.Type, namedTagKey string) ([]*Field, error) {
+	ctx := validator.ContextWithNamedTagKey(context.Background(), namedTagKey)
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return nil, err
+	}
+	return FromValidator(v), nil
+}
+
+// FromValidator returns attributes of each field of compiled struct validator,
+// returns nil when v is not a struct validator
+func FromValidator(v validator.Validator) []*Field {
+	structValidator, ok := validator.UnwrapValidatorLoader(v).(*validator.StructValidator)
+	if !ok {
+		return nil
+	}
+	fields := make([]*Field, 0)
+	appendFields(&fields, structValidator, "", map[*validator.StructValidator]bool{})
+	return fields
+}
+
+// appendFields flattens fields of nested structs, fields of recursive struct types will be skipped
+func appendFields(fields *[]*Field, structValidator *validator.StructValidator, prefix string, visiting map[*validator.StructValidator]bool) {
+	visiting[structValidator] = true
+	defer delete(visiting, structValidator)
+
+	for _, structField := range structValidator.Fields() {
+		name := prefix + structField.DisplayName
+
+		if nested, ok := validator.UnwrapValidatorLoader(structField.Validator).(*validator.StructValidator); ok {
+			if !visiting[nested] {
+				appendFields(fields, nested, name+".", visiting)
+			}
+			continue
+		}
+
+		field := &Field{Name: name}
+
+		// empty input is a valid zero value in presence mode or of driver.Valuer
+		if loader, ok := structField.Validator.(*validator.ValidatorLoader); ok && !loader.Optional && !loader.ZeroValidated() {
+			field.Attrs.add("required", "")
+		}
+
+		field.Options = resolve(&field.Attrs, validator.UnwrapValidatorLoader(structField.Validator))
+
+		// default values provided by DefaultValueProvider are computed by server
+		if loader, ok := structField.Validator.(*validator.ValidatorLoader); ok {
+			if defaultValue := loader.LiteralDefaultValue(); defaultValue != nil && isScalar(structField.Type) {
+				field.Attrs.add("value", string(defaultValue))
+			}
+		}
+
+		*fields = append(*fields, field)
+	}
+}
+
+func resolve(attrs *Attrs, v validator.Validator) []string {
+	switch x := v.(type) {
+	case *validator.StringValidator:
+		if x.MinLength > 0 {
+			attrs.add("minlength", strconv.FormatUint(x.MinLength, 10))
+		}
+		if x.MaxLength != nil {
+			attrs.add("maxlength", strconv.FormatUint(*x.MaxLength, 10))
+		}
+		if x.Pattern != nil {
+			if pattern, ok := ECMAScriptPattern(x.Pattern); ok {
+				attrs.add("pattern", pattern)
+			}
+		}
+		if x.Enums != nil {
+			return validator.EnumValues(x.Enums)
+		}
+	case *validator.StrfmtValidator:
+		if re := x.Pattern(); re != nil {
+			if pattern, ok := ECMAScriptPattern(re); ok {
+				attrs.add("pattern", pattern)
+			}
+		}
+	case *validator.IntValidator:
+		// skip bounds of bit size, which are defaults
+		if x.Minimum != nil && *x.Minimum != validator.MinInt(x.BitSize) {
+			min := *x.Minimum
+			if x.ExclusiveMinimum {
+				min++
+			}
+			attrs.add("min", strconv.FormatInt(min, 10))
+		}
+		if x.Maximum != nil && *x.Maximum != validator.MaxInt(x.BitSize) {
+			max := *x.Maximum
+			if x.ExclusiveMaximum {
+				max--
+			}
+			attrs.add("max", strconv.FormatInt(max, 10))
+		}
+		if x.MultipleOf != 0 {
+			attrs.add("step", strconv.FormatInt(x.MultipleOf, 10))
+		}
+		if x.Enums != nil {
+			return validator.EnumValues(x.Enums)
+		}
+	case *validator.UintValidator:
+		if x.Minimum != 0 || x.ExclusiveMinimum {
+			min := x.Minimum
+			if x.ExclusiveMinimum {
+				min++
+			}
+			attrs.add("min", strconv.FormatUint(min, 10))
+		}
+		if x.Maximum != validator.MaxUint(x.BitSize) {
+			max := x.Maximum
+			if x.ExclusiveMaximum {
+				max--
+			}
+			attrs.add("max", strconv.FormatUint(max, 10))
+		}
+		if x.MultipleOf != 0 {
+			attrs.add("step", strconv.FormatUint(x.MultipleOf, 10))
+		}
+		if x.Enums != nil {
+			return validator.EnumValues(x.Enums)
+		}
+	case *validator.FloatValidator:
+		// exclusive bounds could not be expressed, the inclusive ones are used
+		if x.Minimum != nil {
+			attrs.add("min", formatFloat(*x.Minimum))
+		}
+		if x.Maximum != nil {
+			attrs.add("max", formatFloat(*x.Maximum))
+		}
+		if x.MultipleOf != 0 {
+			attrs.add("step", formatFloat(x.MultipleOf))
+		} else if x.DecimalDigits != nil {
+			// default step of number input is 1
+			attrs.add("step", formatFloat(math.Pow10(-int(*x.DecimalDigits))))
+		}
+		if x.Enums != nil {
+			return validator.EnumValues(x.Enums)
+		}
+	}
+	return nil
+}
+
+func isScalar(typ typesutil.Type) bool {
+	if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); ok {
+		return true
+	}
+	if elemType, ok := validator.ValuerElemType(typ); ok {
+		return isScalar(elemType)
+	}
+	switch typesutil.Deref(typ).Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct, reflect.Interface:
+		return false
+	}
+	return true
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
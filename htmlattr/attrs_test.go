@@ -0,0 +1,129 @@
+package htmlattr
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Address struct {
+	Zip string `json:"zip" validate:"@string/^[0-9]{6}$/"`
+}
+
+type Account struct {
+	Name    string  `json:"name" validate:"@string[1,32]"`
+	Nick    string  `json:"nick,omitempty" validate:"@char[,10]"`
+	Age     int     `json:"age" validate:"@int[18,120)"`
+	Level   uint    `json:"level" validate:"@uint{%5}"`
+	Balance float64 `json:"balance" validate:"@float<10,2>[0,]"`
+	Role    string  `json:"role" validate:"@string{ADMIN,MEMBER}"`
+	Address Address `json:"address"`
+}
+
+func ExampleFromType() {
+	fields, err := FromType(reflect.TypeOf(Account{}), "json")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, field := range fields {
+		fmt.Println(field.Name, field.Attrs, field.Options)
+	}
+	// Output:
+	// name required minlength="1" maxlength="32" []
+	// nick maxlength="10" []
+	// age required min="18" max="119" []
+	// level required step="5" []
+	// balance required min="0" step="0.01" []
+	// role required [ADMIN MEMBER]
+	// address.zip required pattern="^[0-9]{6}$" []
+}
+
+func TestFromType(t *testing.T) {
+	t.Run("not struct", func(t *testing.T) {
+		fields, err := FromType(reflect.TypeOf(""), "json")
+		require.NoError(t, err)
+		require.Nil(t, fields)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		_, err := FromType(reflect.TypeOf(struct {
+			Name string `validate:"@int"`
+		}{}), "json")
+		require.Error(t, err)
+	})
+
+	t.Run("numeric enums", func(t *testing.T) {
+		fields, err := FromType(reflect.TypeOf(struct {
+			Size int `json:"size" validate:"@int{10,9,100}"`
+		}{}), "json")
+		require.NoError(t, err)
+		require.Equal(t, []string{"9", "10", "100"}, fields[0].Options)
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		fields, err := FromType(reflect.TypeOf(struct {
+			Count int    `json:"count" validate:"@int[0,10]!"`
+			Note  string `json:"note" validate:"@string[,10]!"`
+			Size  int    `json:"size" validate:"@int[0,10]"`
+		}{}), "json")
+		require.NoError(t, err)
+		require.Equal(t, `min="0" max="10"`, fields[0].Attrs.String())
+		require.Equal(t, `maxlength="10"`, fields[1].Attrs.String())
+		require.Equal(t, `required min="0" max="10"`, fields[2].Attrs.String())
+	})
+
+	t.Run("default values", func(t *testing.T) {
+		fields, err := FromType(reflect.TypeOf(struct {
+			Role  string   `json:"role,omitempty" validate:"@string{ADMIN,MEMBER}" default:"MEMBER"`
+			At    string   `json:"at,omitempty" default:"$now"`
+			Price string   `json:"price,omitempty" default:"$$5"`
+			Tags  []string `json:"tags,omitempty" default:"[\"a\"]"`
+		}{}), "json")
+		require.NoError(t, err)
+		require.Equal(t, `value="MEMBER"`, fields[0].Attrs.String())
+		require.Empty(t, fields[1].Attrs)
+		require.Equal(t, `value="$5"`, fields[2].Attrs.String())
+		require.Empty(t, fields[3].Attrs)
+	})
+
+	t.Run("sql null types", func(t *testing.T) {
+		fields, err := FromType(reflect.TypeOf(struct {
+			Name  sql.NullString `json:"name" validate:"@string[1,10]"`
+			Count sql.NullInt64  `json:"count,omitempty" validate:"@int[0,10]" default:"5"`
+			At    sql.NullTime   `json:"at,omitempty"`
+		}{}), "json")
+		require.NoError(t, err)
+		require.Len(t, fields, 3)
+		require.Equal(t, `minlength="1" maxlength="10"`, fields[0].Attrs.String())
+		require.Equal(t, `min="0" max="10" value="5"`, fields[1].Attrs.String())
+		require.Empty(t, fields[2].Attrs)
+	})
+
+	t.Run("recursive struct", func(t *testing.T) {
+		type Person struct {
+			Name   string  `json:"name" validate:"@string[1,]"`
+			Mentor *Person `json:"mentor,omitempty"`
+		}
+
+		fields, err := FromType(reflect.TypeOf(Person{}), "json")
+		require.NoError(t, err)
+		require.Len(t, fields, 1)
+		require.Equal(t, "name", fields[0].Name)
+	})
+
+	t.Run("attrs", func(t *testing.T) {
+		attrs := Attrs{{Name: "required"}, {Name: "pattern", Value: `"<a>"`}}
+		require.Equal(t, `required pattern="&#34;&lt;a&gt;&#34;"`, attrs.String())
+
+		v, ok := attrs.Get("pattern")
+		require.True(t, ok)
+		require.Equal(t, `"<a>"`, v)
+
+		_, ok = attrs.Get("min")
+		require.False(t, ok)
+	})
+}
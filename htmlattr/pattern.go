@@ -0,0 +1,14 @@
+package htmlattr
+
+import (
+	"regexp"
+
+	"github.com/go-courier/validator/internal/ecmascript"
+)
+
+// ECMAScriptPattern converts Go regexp to value of `pattern` attribute,
+// which will be anchored as ^(?:pattern)$ and compiled with `v` flag by browsers.
+// Returns false when the regexp could not be expressed, like multi-line mode.
+func ECMAScriptPattern(re *regexp.Regexp) (string, bool) {
+	return ecmascript.Pattern(re)
+}
@@ -0,0 +1,34 @@
+package htmlattr
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestECMAScriptPattern(t *testing.T) {
+	cases := []struct {
+		re      string
+		pattern string
+		ok      bool
+	}{
+		{`^[a-z]+$`, `^[a-z]+$`, true},
+		{`^\d{2,}-\d{1,3}$`, `^[0-9]{2,}-[0-9]{1,3}$`, true},
+		{`\w+@\w+`, `[\s\S]*(?:[0-9A-Z\u{5F}a-z]+@[0-9A-Z\u{5F}a-z]+)[\s\S]*`, true},
+		{`^(a|bc)+?`, `(?:^(a|bc)+?)[\s\S]*`, true},
+		{`^(?P<year>\d{4})/x.$`, `^(?<year>[0-9]{4})\/x[^\n]$`, true},
+		{`^(?i)ab$`, `^[Aa][Bb]$`, true},
+		{`^a|b$`, `[\s\S]*(?:^a|b$)[\s\S]*`, true},
+		{`^[^a]$`, `^[\u{0}-\u{60}b-\u{10FFFF}]$`, true},
+		{`(?m)^a$`, ``, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.re, func(t *testing.T) {
+			pattern, ok := ECMAScriptPattern(regexp.MustCompile(c.re))
+			require.Equal(t, c.ok, ok)
+			require.Equal(t, c.pattern, pattern)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package httpvalidate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+const TagJSON = "json"
+
+/*
+Middleware creates middleware to decode JSON request body into new value of the type of body,
+fill default values and validate it with cached compiled validator.
+
+	type CreateUser struct {
+		Name string `json:"name" validate:"@string[1,]"`
+		Role string `json:"role,omitempty" validate:"@string{ADMIN,MEMBER}" default:"MEMBER"`
+	}
+
+	http.Handle("/users", httpvalidate.Middleware(CreateUser{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := httpvalidate.BodyFromContext(r.Context()).(*CreateUser)
+	})))
+
+Or with typed body by Handler:
+
+	http.Handle("/users", httpvalidate.Handler(func(w http.ResponseWriter, r *http.Request, body *CreateUser) {
+	}))
+
+When failed, an RFC 7807 problem+json response with status 400 will be written by WriteProblem, and next handler will not be called.
+Warnings will not fail the request.
+*/
+func Middleware(body interface{}) func(next http.Handler) http.Handler {
+	typ := reflect.TypeOf(body)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	// compile first to panic early when rules of typ are invalid
+	if _, err := validatorOf(typ); err != nil {
+		panic(err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ptr, err := DecodeAndValidate(r, typ)
+			if err != nil {
+				WriteProblem(w, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(ContextWithBody(r.Context(), ptr)))
+		})
+	}
+}
+
+// HandlerFunc wraps fn with decoded and validated body, body will be pointer of the type of body
+func HandlerFunc(body interface{}, fn func(w http.ResponseWriter, r *http.Request, body interface{})) http.Handler {
+	return Middleware(body)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fn(w, r, BodyFromContext(r.Context()))
+	}))
+}
+
+// Handler is typed HandlerFunc, which wraps fn with decoded and validated body of T
+func Handler[T any](fn func(w http.ResponseWriter, r *http.Request, body *T)) http.Handler {
+	return Middleware(new(T))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := Body[T](r.Context())
+		fn(w, r, body)
+	}))
+}
+
+// Body returns body of T in context, which is set by Middleware
+func Body[T any](ctx context.Context) (*T, bool) {
+	body, ok := BodyFromContext(ctx).(*T)
+	return body, ok
+}
+
+// Decode is typed DecodeAndValidate, which decodes JSON body of r into new value of T and validates it
+func Decode[T any](r *http.Request) (*T, error) {
+	ptr, err := DecodeAndValidate(r, reflect.TypeOf(new(T)).Elem())
+	if err != nil {
+		return nil, err
+	}
+	return ptr.(*T), nil
+}
+
+type contextKeyBody int
+
+func ContextWithBody(ctx context.Context, body interface{}) context.Context {
+	return context.WithValue(ctx, contextKeyBody(1), body)
+}
+
+func BodyFromContext(ctx context.Context) interface{} {
+	return ctx.Value(contextKeyBody(1))
+}
+
+// DecodeAndValidate decodes JSON body of r into new value of typ and validates it, returns pointer of the value
+func DecodeAndValidate(r *http.Request, typ reflect.Type) (interface{}, error) {
+	v, err := validatorOf(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := reflect.New(typ)
+
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, &DecodeError{Err: fmt.Errorf("missing request body")}
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(rv.Interface()); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+
+	if err, _ := errors.Split(v.Validate(rv.Elem())); err != nil {
+		return nil, err
+	}
+
+	return rv.Interface(), nil
+}
+
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("invalid request body: %s", e.Err)
+}
+
+func validatorOf(typ reflect.Type) (validator.Validator, error) {
+	return validator.ValidatorMgrDefault.CompileCached(TagJSON, nil, typ)
+}
+
+// WriteProblem writes err as RFC 7807 problem+json response by errors.DefaultProblemOptions,
+// status will be 400 for *errors.ErrorSet and *DecodeError, otherwise 500.
+func WriteProblem(w http.ResponseWriter, err error) {
+	var problem *errors.ProblemDetails
+
+	switch e := err.(type) {
+	case *errors.ErrorSet:
+		problem = e.ProblemDetails(nil)
+	case *DecodeError:
+		opts := *errors.DefaultProblemOptions
+		opts.Detail = e.Error()
+		problem = errors.NewErrorSet("").ProblemDetails(&opts)
+	default:
+		opts := *errors.DefaultProblemOptions
+		opts.Title = ""
+		opts.Status = http.StatusInternalServerError
+		opts.Detail = e.Error()
+		problem = errors.NewErrorSet("").ProblemDetails(&opts)
+	}
+
+	w.Header().Set("Content-Type", errors.ContentTypeProblemJSON)
+	w.WriteHeader(problem.Status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
@@ -0,0 +1,129 @@
+package httpvalidate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type CreateUser struct {
+	Name  string   `json:"name" validate:"@string[1,]"`
+	Role  string   `json:"role,omitempty" validate:"@string{ADMIN}" default:"ADMIN"`
+	Tags  []string `json:"tags,omitempty" validate:"@slice<@string[1,]>"`
+	Title string   `json:"title,omitempty" validate:"@string[,3]" severity:"warn"`
+}
+
+func TestMiddleware(t *testing.T) {
+	handler := HandlerFunc(CreateUser{}, func(w http.ResponseWriter, r *http.Request, body interface{}) {
+		_ = json.NewEncoder(w).Encode(body)
+	})
+
+	serve := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, req)
+		return rw
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		rw := serve(`{"name":"a","title":"too long"}`)
+		require.Equal(t, http.StatusOK, rw.Code)
+
+		user := &CreateUser{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), user))
+		require.Equal(t, "ADMIN", user.Role)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		rw := serve(`{"role":"MEMBER","tags":["a",""]}`)
+		require.Equal(t, http.StatusBadRequest, rw.Code)
+		require.Equal(t, errors.ContentTypeProblemJSON, rw.Header().Get("Content-Type"))
+
+		problem := &errors.ProblemDetails{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), problem))
+		require.Equal(t, http.StatusBadRequest, problem.Status)
+		require.Len(t, problem.InvalidParams, 3)
+		require.Equal(t, "name", problem.InvalidParams[0].Name)
+		require.Equal(t, "role", problem.InvalidParams[1].Name)
+		require.Equal(t, "tags[1]", problem.InvalidParams[2].Name)
+		require.Equal(t, errors.CodeMissingRequired, problem.InvalidParams[2].Code)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		rw := serve(`{"name":`)
+		require.Equal(t, http.StatusBadRequest, rw.Code)
+
+		problem := &errors.ProblemDetails{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), problem))
+		require.Contains(t, problem.Detail, "invalid request body")
+	})
+
+	t.Run("malformed with default problem options", func(t *testing.T) {
+		defaultProblemOptions := errors.DefaultProblemOptions
+		defer func() {
+			errors.DefaultProblemOptions = defaultProblemOptions
+		}()
+
+		errors.DefaultProblemOptions = &errors.ProblemOptions{
+			Type:     "https://errors.example.com/validation",
+			Title:    "Invalid Request",
+			Instance: "/users",
+		}
+
+		rw := serve(`{"name":`)
+		require.Equal(t, http.StatusBadRequest, rw.Code)
+
+		problem := &errors.ProblemDetails{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), problem))
+		require.Equal(t, "https://errors.example.com/validation", problem.Type)
+		require.Equal(t, "Invalid Request", problem.Title)
+		require.Equal(t, "/users", problem.Instance)
+		require.Contains(t, problem.Detail, "invalid request body")
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		type Invalid struct {
+			Name string `validate:"@int"`
+		}
+		require.Panics(t, func() {
+			Middleware(Invalid{})
+		})
+	})
+}
+
+func TestHandler(t *testing.T) {
+	handler := Handler(func(w http.ResponseWriter, r *http.Request, body *CreateUser) {
+		_, _ = w.Write([]byte(body.Name + " " + body.Role))
+	})
+
+	t.Run("passed", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"a"}`)))
+		require.Equal(t, http.StatusOK, rw.Code)
+		require.Equal(t, "a ADMIN", rw.Body.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		rw := httptest.NewRecorder()
+		handler.ServeHTTP(rw, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`)))
+		require.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+}
+
+func TestDecode(t *testing.T) {
+	user, err := Decode[CreateUser](httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"name":"a"}`)))
+	require.NoError(t, err)
+	require.Equal(t, &CreateUser{Name: "a", Role: "ADMIN"}, user)
+
+	_, err = Decode[CreateUser](httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{"role":"ADMIN"}`)))
+	require.Error(t, err)
+	require.IsType(t, &errors.ErrorSet{}, err)
+
+	_, err = Decode[CreateUser](httptest.NewRequest(http.MethodPost, "/users", nil))
+	require.IsType(t, &DecodeError{}, err)
+}
@@ -110,8 +110,11 @@ func (validator *IntValidator) Validate(v interface{}) error {
 		return errors.NewUnsupportedTypeError(rv.Type().String(), validator.String())
 	}
 
-	val := rv.Int()
+	return validator.validateInt(rv.Int())
+}
 
+// validateInt validates int value without reflect
+func (validator *IntValidator) validateInt(val int64) error {
 	if validator.Enums != nil {
 		if _, ok := validator.Enums[val]; !ok {
 			values := make([]interface{}, 0)
@@ -134,7 +137,7 @@ func (validator *IntValidator) Validate(v interface{}) error {
 	if ((validator.ExclusiveMinimum && val == mininum) || val < mininum) ||
 		((validator.ExclusiveMaximum && val == maxinum) || val > maxinum) {
 		return &errors.OutOfRangeError{
-			Target:           TargetFloatValue,
+			Target:           TargetIntValue,
 			Current:          val,
 			Minimum:          mininum,
 			ExclusiveMinimum: validator.ExclusiveMinimum,
@@ -146,7 +149,7 @@ func (validator *IntValidator) Validate(v interface{}) error {
 	if validator.MultipleOf != 0 {
 		if val%validator.MultipleOf != 0 {
 			return &errors.MultipleOfError{
-				Target:     TargetFloatValue,
+				Target:     TargetIntValue,
 				Current:    val,
 				MultipleOf: validator.MultipleOf,
 			}
@@ -333,3 +336,28 @@ func (validator *IntValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *IntValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeInteger}
+
+	if validator.Enums != nil {
+		return d.add(enumConstraint(TargetIntValue, validator.Enums))
+	}
+
+	var minimum, maximum interface{}
+	// bounds of bit size are defaults
+	if validator.Minimum != nil && *validator.Minimum != MinInt(validator.BitSize) {
+		minimum = *validator.Minimum
+	}
+	if validator.Maximum != nil && *validator.Maximum != MaxInt(validator.BitSize) {
+		maximum = *validator.Maximum
+	}
+
+	d.add(rangeConstraint(TargetIntValue, minimum, maximum, validator.ExclusiveMinimum, validator.ExclusiveMaximum))
+
+	if validator.MultipleOf != 0 {
+		d.add(multipleOfConstraint(TargetIntValue, validator.MultipleOf))
+	}
+
+	return d
+}
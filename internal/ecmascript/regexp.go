@@ -0,0 +1,234 @@
+package ecmascript
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RegExp converts Go regexp to source of ECMAScript RegExp, which should be compiled with `u` or `v` flag.
+// Returns false when the regexp could not be expressed, like multi-line mode.
+func RegExp(re *regexp.Regexp) (string, bool) {
+	source, _, ok := convert(re)
+	return source, ok
+}
+
+// Pattern converts Go regexp to value of `pattern` attribute of HTML input,
+// which will be anchored as ^(?:pattern)$ and compiled with `v` flag by browsers.
+func Pattern(re *regexp.Regexp) (string, bool) {
+	source, r, ok := convert(re)
+	if !ok {
+		return "", false
+	}
+
+	// Go regexp matches any substring, but pattern attribute matches whole value
+	anchoredLeft, anchoredRight := anchored(r)
+	if anchoredLeft && anchoredRight {
+		return source, true
+	}
+
+	pattern := "(?:" + source + ")"
+	if !anchoredLeft {
+		pattern = `[\s\S]*` + pattern
+	}
+	if !anchoredRight {
+		pattern = pattern + `[\s\S]*`
+	}
+	return pattern, true
+}
+
+func convert(re *regexp.Regexp) (string, *syntax.Regexp, bool) {
+	r, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", nil, false
+	}
+
+	b := &strings.Builder{}
+	if !writeRegexp(b, r) {
+		return "", nil, false
+	}
+	return b.String(), r, true
+}
+
+func anchored(r *syntax.Regexp) (bool, bool) {
+	switch r.Op {
+	case syntax.OpBeginText:
+		return true, false
+	case syntax.OpEndText:
+		return false, true
+	case syntax.OpConcat:
+		if len(r.Sub) == 0 {
+			return false, false
+		}
+		return r.Sub[0].Op == syntax.OpBeginText, r.Sub[len(r.Sub)-1].Op == syntax.OpEndText
+	}
+	return false, false
+}
+
+func writeRegexp(b *strings.Builder, r *syntax.Regexp) bool {
+	switch r.Op {
+	case syntax.OpNoMatch:
+		b.WriteString("[]")
+	case syntax.OpEmptyMatch:
+		b.WriteString("(?:)")
+	case syntax.OpLiteral:
+		for _, c := range r.Rune {
+			if r.Flags&syntax.FoldCase != 0 && unicode.SimpleFold(c) != c {
+				writeFoldedRune(b, c)
+				continue
+			}
+			writeRune(b, c)
+		}
+	case syntax.OpCharClass:
+		writeCharClass(b, r.Rune)
+	case syntax.OpAnyCharNotNL:
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginText:
+		b.WriteString("^")
+	case syntax.OpEndText:
+		b.WriteString("$")
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		b.WriteString("(")
+		if r.Name != "" {
+			b.WriteString("?<" + r.Name + ">")
+		}
+		if !writeRegexp(b, r.Sub[0]) {
+			return false
+		}
+		b.WriteString(")")
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		if !writeRepeated(b, r.Sub[0]) {
+			return false
+		}
+		switch r.Op {
+		case syntax.OpStar:
+			b.WriteString("*")
+		case syntax.OpPlus:
+			b.WriteString("+")
+		case syntax.OpQuest:
+			b.WriteString("?")
+		default:
+			b.WriteString("{" + strconv.Itoa(r.Min))
+			if r.Max != r.Min {
+				b.WriteString(",")
+				if r.Max >= 0 {
+					b.WriteString(strconv.Itoa(r.Max))
+				}
+			}
+			b.WriteString("}")
+		}
+		if r.Flags&syntax.NonGreedy != 0 {
+			b.WriteString("?")
+		}
+	case syntax.OpConcat:
+		for _, sub := range r.Sub {
+			if sub.Op == syntax.OpAlternate {
+				b.WriteString("(?:")
+				if !writeRegexp(b, sub) {
+					return false
+				}
+				b.WriteString(")")
+				continue
+			}
+			if !writeRegexp(b, sub) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range r.Sub {
+			if i > 0 {
+				b.WriteString("|")
+			}
+			if !writeRegexp(b, sub) {
+				return false
+			}
+		}
+	default:
+		// OpBeginLine, OpEndLine of multi-line mode
+		return false
+	}
+	return true
+}
+
+func writeRepeated(b *strings.Builder, sub *syntax.Regexp) bool {
+	switch sub.Op {
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL, syntax.OpCapture:
+		return writeRegexp(b, sub)
+	case syntax.OpLiteral:
+		if len(sub.Rune) == 1 {
+			return writeRegexp(b, sub)
+		}
+	}
+	b.WriteString("(?:")
+	if !writeRegexp(b, sub) {
+		return false
+	}
+	b.WriteString(")")
+	return true
+}
+
+const syntaxChars = `\^$.|?*+()[]{}/`
+
+func writeRune(b *strings.Builder, c rune) {
+	if c < unicode.MaxASCII && strings.ContainsRune(syntaxChars, c) {
+		b.WriteRune('\\')
+		b.WriteRune(c)
+		return
+	}
+	if !unicode.IsPrint(c) || c == ' ' {
+		writeEscapedRune(b, c)
+		return
+	}
+	b.WriteRune(c)
+}
+
+func writeFoldedRune(b *strings.Builder, c rune) {
+	runes := []rune{c}
+	for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+		runes = append(runes, f)
+	}
+	b.WriteString("[")
+	for _, r := range runes {
+		writeClassRune(b, r)
+	}
+	b.WriteString("]")
+}
+
+func writeCharClass(b *strings.Builder, ranges []rune) {
+	if len(ranges) == 2 && ranges[0] == 0 && ranges[1] == unicode.MaxRune {
+		b.WriteString(`[\s\S]`)
+		return
+	}
+	b.WriteString("[")
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		writeClassRune(b, lo)
+		if hi != lo {
+			b.WriteString("-")
+			writeClassRune(b, hi)
+		}
+	}
+	b.WriteString("]")
+}
+
+// inside class with `v` flag, most of punctuators are reserved, so escape all of them
+func writeClassRune(b *strings.Builder, c rune) {
+	if unicode.IsLetter(c) || unicode.IsDigit(c) {
+		b.WriteRune(c)
+		return
+	}
+	writeEscapedRune(b, c)
+}
+
+func writeEscapedRune(b *strings.Builder, c rune) {
+	b.WriteString(fmt.Sprintf(`\u{%X}`, c))
+}
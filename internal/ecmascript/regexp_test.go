@@ -0,0 +1,29 @@
+package ecmascript
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegExp(t *testing.T) {
+	cases := []struct {
+		re     string
+		source string
+		ok     bool
+	}{
+		{`^[a-z]+$`, `^[a-z]+$`, true},
+		{`a/b`, `a\/b`, true},
+		{`\w+@\w+`, `[0-9A-Z\u{5F}a-z]+@[0-9A-Z\u{5F}a-z]+`, true},
+		{`(?m)^a$`, ``, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.re, func(t *testing.T) {
+			source, ok := RegExp(regexp.MustCompile(c.re))
+			require.Equal(t, c.ok, ok)
+			require.Equal(t, c.source, source)
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package validator
+
+import (
+	"encoding"
+	"encoding/json"
+	"go/ast"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+)
+
+// NewJSONValueValidator creates JSONValueValidator by the compiled validator of typ.
+// Keys of objects will be mapped by the named tag key of the compiled StructValidator.
+func NewJSONValueValidator(validator Validator, typ reflect.Type) *JSONValueValidator {
+	namedTagKey := ""
+
+	v := validator
+	if loader, ok := v.(*ValidatorLoader); ok {
+		v = loader.Validator
+	}
+	if structValidator, ok := v.(*StructValidator); ok {
+		namedTagKey = structValidator.NamedTagKey()
+	}
+
+	return &JSONValueValidator{
+		Validator:   validator,
+		Type:        typ,
+		NamedTagKey: namedTagKey,
+	}
+}
+
+/*
+JSONValueValidator validates loosely typed JSON values (decoded by encoding/json into interface{})
+against rules of the Go type, before unmarshalling into the real type.
+
+JSON numbers (float64 or json.Number) will be coerced to int, uint or float for range checks.
+Type mismatches and unknown keys (only in strict mode) will be reported in errors.ErrorSet.
+*/
+type JSONValueValidator struct {
+	Validator   Validator
+	Type        reflect.Type
+	NamedTagKey string
+	// report unknown keys of objects
+	Strict bool
+}
+
+func (validator *JSONValueValidator) String() string {
+	return validator.Validator.String()
+}
+
+func (validator *JSONValueValidator) Describe() *Description {
+	return Describe(validator.Validator)
+}
+
+func (validator *JSONValueValidator) Validate(data interface{}) error {
+	rv := reflect.New(validator.Type).Elem()
+
+	errSet := errors.NewErrorSet("")
+	failed := make([]errors.KeyPath, 0)
+
+	c := &jsonValueCoercer{
+		namedTagKey: validator.NamedTagKey,
+		strict:      validator.Strict,
+		onErr: func(err error, keyPath errors.KeyPath) {
+			failed = append(failed, keyPath)
+			errSet.AddErr(err, keyPath...)
+		},
+	}
+
+	c.coerce(data, rv, errors.KeyPath{}, validator.Validator)
+
+	// values failed to coerce will be reported as missing, skip them
+	errSet.Merge(validator.Validator.Validate(rv), nil, failed...)
+
+	return errSet.Err()
+}
+
+type jsonValueCoercer struct {
+	namedTagKey string
+	strict      bool
+	onErr       func(err error, keyPath errors.KeyPath)
+}
+
+var typTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// coerce sets data to rv, validator of the value is used to find out required fields in presence mode
+func (c *jsonValueCoercer) coerce(data interface{}, rv reflect.Value, keyPath errors.KeyPath, validator Validator) {
+	// null as missing
+	if data == nil {
+		return
+	}
+
+	mismatch := func(expect string) {
+		c.onErr(&errors.TypeMismatchError{Expect: expect, Current: data}, keyPath)
+	}
+
+	if rv.Kind() == reflect.Ptr {
+		elem := reflect.New(rv.Type().Elem())
+		c.coerce(data, elem.Elem(), keyPath, validator)
+		rv.Set(elem)
+		return
+	}
+
+	if reflect.PtrTo(rv.Type()).Implements(typTextUnmarshaler) {
+		s, ok := data.(string)
+		if !ok {
+			mismatch("string")
+			return
+		}
+		if err := reflectx.UnmarshalText(rv, []byte(s)); err != nil {
+			c.onErr(err, keyPath)
+		}
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		rv.Set(reflect.ValueOf(data))
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			mismatch("string")
+			return
+		}
+		rv.SetString(s)
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			mismatch("boolean")
+			return
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bitSize := uint(rv.Type().Bits())
+		if n, ok := data.(json.Number); ok {
+			// avoid precision lost of large integers
+			if i, err := n.Int64(); err == nil && !rv.OverflowInt(i) {
+				rv.SetInt(i)
+				return
+			}
+		}
+		f, ok := jsonNumber(data)
+		if !ok || f != math.Trunc(f) {
+			mismatch("integer")
+			return
+		}
+		if f < -math.Ldexp(1, int(bitSize)-1) || f >= math.Ldexp(1, int(bitSize)-1) {
+			c.onErr(&errors.OutOfRangeError{
+				Target:  TargetIntValue,
+				Current: data,
+				Minimum: MinInt(bitSize),
+				Maximum: MaxInt(bitSize),
+			}, keyPath)
+			return
+		}
+		rv.SetInt(int64(f))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		bitSize := uint(rv.Type().Bits())
+		if n, ok := data.(json.Number); ok {
+			if u, err := strconv.ParseUint(string(n), 10, 64); err == nil && !rv.OverflowUint(u) {
+				rv.SetUint(u)
+				return
+			}
+		}
+		f, ok := jsonNumber(data)
+		if !ok || f != math.Trunc(f) {
+			mismatch("integer")
+			return
+		}
+		if f < 0 || f >= math.Ldexp(1, int(bitSize)) {
+			c.onErr(&errors.OutOfRangeError{
+				Target:  TargetUintValue,
+				Current: data,
+				Minimum: uint64(0),
+				Maximum: MaxUint(bitSize),
+			}, keyPath)
+			return
+		}
+		rv.SetUint(uint64(f))
+	case reflect.Float32, reflect.Float64:
+		f, ok := jsonNumber(data)
+		if !ok {
+			mismatch("number")
+			return
+		}
+		if rv.OverflowFloat(f) {
+			c.onErr(&errors.OutOfRangeError{
+				Target:  TargetFloatValue,
+				Current: data,
+				Minimum: -math.MaxFloat32,
+				Maximum: math.MaxFloat32,
+			}, keyPath)
+			return
+		}
+		rv.SetFloat(f)
+	case reflect.Slice, reflect.Array:
+		list, ok := data.([]interface{})
+		if !ok {
+			mismatch("array")
+			return
+		}
+		if rv.Kind() == reflect.Slice {
+			rv.Set(reflect.MakeSlice(rv.Type(), len(list), len(list)))
+		}
+		var elemValidator Validator
+		if sliceValidator, ok := UnwrapValidatorLoader(validator).(*SliceValidator); ok {
+			elemValidator = sliceValidator.ElemValidator
+		}
+		for i := range list {
+			if i >= rv.Len() {
+				break
+			}
+			c.coerce(list[i], rv.Index(i), childKeyPath(keyPath, i), elemValidator)
+		}
+	case reflect.Map:
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			mismatch("object")
+			return
+		}
+		rv.Set(reflect.MakeMapWithSize(rv.Type(), len(object)))
+		var elemValidator Validator
+		if mapValidator, ok := UnwrapValidatorLoader(validator).(*MapValidator); ok {
+			elemValidator = mapValidator.ElemValidator
+		}
+		for k, v := range object {
+			key := reflect.New(rv.Type().Key()).Elem()
+			if err := reflectx.UnmarshalText(key, []byte(k)); err != nil {
+				c.onErr(err, childKeyPath(keyPath, k+"/key"))
+				continue
+			}
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			c.coerce(v, elem, childKeyPath(keyPath, k), elemValidator)
+			rv.SetMapIndex(key, elem)
+		}
+	case reflect.Struct:
+		object, ok := data.(map[string]interface{})
+		if !ok {
+			mismatch("object")
+			return
+		}
+		known := map[string]bool{}
+		structValidator, _ := UnwrapValidatorLoader(validator).(*StructValidator)
+		c.coerceStruct(object, rv, keyPath, known, structValidator)
+		if c.strict {
+			for k := range object {
+				if !known[k] {
+					c.onErr(errors.UnknownFieldError{}, childKeyPath(keyPath, k))
+				}
+			}
+		}
+	}
+}
+
+func (c *jsonValueCoercer) coerceStruct(object map[string]interface{}, rv reflect.Value, keyPath errors.KeyPath, known map[string]bool, structValidator *StructValidator) {
+	typ := rv.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, _, exists := typesutil.FieldDisplayName(field.Tag, c.namedTagKey, field.Name)
+		if !ast.IsExported(field.Name) || name == "-" {
+			continue
+		}
+
+		fieldValue := rv.Field(i)
+
+		if field.Anonymous && !exists && reflectx.Deref(field.Type).Kind() == reflect.Struct {
+			if fieldValue.Kind() == reflect.Ptr {
+				fieldValue.Set(reflect.New(field.Type.Elem()))
+				fieldValue = fieldValue.Elem()
+			}
+			c.coerceStruct(object, fieldValue, keyPath, known, structValidator)
+			continue
+		}
+
+		known[name] = true
+
+		var fieldValidator Validator
+		if structValidator != nil {
+			fieldValidator = structValidator.fieldValidators[field.Name]
+		}
+
+		v, ok := object[name]
+		if !ok || v == nil {
+			// zero value could not tell absent key in presence mode
+			if loader, ok := fieldValidator.(*ValidatorLoader); ok && loader.Presence && !loader.Optional {
+				c.onErr(errors.MissingRequiredFieldError{}, childKeyPath(keyPath, name))
+			}
+			continue
+		}
+
+		c.coerce(v, fieldValue, childKeyPath(keyPath, name), fieldValidator)
+	}
+}
+
+func childKeyPath(keyPath errors.KeyPath, keyOrIndex interface{}) errors.KeyPath {
+	child := make(errors.KeyPath, len(keyPath), len(keyPath)+1)
+	copy(child, keyPath)
+	return append(child, keyOrIndex)
+}
+
+func jsonNumber(data interface{}) (float64, bool) {
+	switch n := data.(type) {
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := strconv.ParseFloat(string(n), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,147 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func ExampleJSONValueValidator() {
+	type Item struct {
+		Count uint8 `json:"count" validate:"@uint8[1,]"`
+	}
+
+	type Order struct {
+		ID     string          `json:"id" validate:"@string[1,]"`
+		Amount int             `json:"amount" validate:"@int[1,100]"`
+		Rate   float64         `json:"rate,omitempty" validate:"@float64<5,2>[0,1]"`
+		Items  []Item          `json:"items" validate:"@slice[1,]"`
+		Labels map[string]int8 `json:"labels,omitempty"`
+	}
+
+	typ := reflect.TypeOf(Order{})
+
+	v := NewJSONValueValidator(
+		ValidatorMgrDefault.MustCompile(ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(typ)),
+		typ,
+	)
+	v.Strict = true
+
+	data := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`{
+		"id": 1,
+		"amount": 101,
+		"rate": 0.5,
+		"items": [{"count": 1}, {"count": 1.5}, {"count": 256}, {}],
+		"labels": {"a": 1000},
+		"extra": true
+	}`), &data)
+
+	err := v.Validate(data)
+
+	messages := make([]string, 0)
+	err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+		messages = append(messages, fmt.Sprintf("%s %s", fieldErr.Field, fieldErr.Error))
+	})
+	sort.Strings(messages)
+
+	fmt.Println(strings.Join(messages, "\n"))
+	// Output:
+	// amount int value should be larger or equal than 1 and less or equal than 100, but got invalid value 101
+	// extra unknown field
+	// id value should be string, but got float64 1
+	// items[1].count value should be integer, but got float64 1.5
+	// items[2].count uint value should be larger or equal than 0 and less or equal than 255, but got invalid value 256
+	// items[3].count missing required field
+	// labels.a int value should be larger or equal than -128 and less or equal than 127, but got invalid value 1000
+}
+
+func TestJSONValueValidator(t *testing.T) {
+	type Sub struct {
+		Name string `json:"name" validate:"@string[1,]"`
+	}
+
+	type Data struct {
+		Big  int64       `json:"big"`
+		Ptr  *Sub        `json:"ptr"`
+		Any  interface{} `json:"any,omitempty"`
+		Dur  Duration    `json:"dur,omitempty" validate:"@string[2,]"`
+		List [2]int      `json:"list,omitempty"`
+		Sub
+	}
+
+	typ := reflect.TypeOf(Data{})
+	v := NewJSONValueValidator(
+		ValidatorMgrDefault.MustCompile(ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(typ)),
+		typ,
+	)
+
+	decode := func(s string) interface{} {
+		d := json.NewDecoder(strings.NewReader(s))
+		d.UseNumber()
+		var data interface{}
+		require.NoError(t, d.Decode(&data))
+		return data
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		require.NoError(t, v.Validate(decode(`{"big":9223372036854775807,"ptr":{"name":"a"},"name":"b","any":[1],"dur":"1s","list":[1,2],"unknown":1}`)))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		err := v.Validate(decode(`{"big":9223372036854775808,"ptr":{"name":1},"dur":1,"list":[1,"2"]}`))
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		sort.Strings(keyPaths)
+
+		require.Equal(t, []string{"big", "dur", "list[1]", "name", "ptr.name"}, keyPaths)
+	})
+
+	t.Run("root mismatch", func(t *testing.T) {
+		err := v.Validate(decode(`[]`))
+		require.Equal(t, " value should be object, but got array\n", err.Error())
+	})
+}
+
+func TestJSONValueValidator_Presence(t *testing.T) {
+	type Data struct {
+		Count    int    `json:"count" validate:"@int[0,10]!"`
+		Name     string `json:"name,omitempty" validate:"@string[0,]!"`
+		Disabled int    `json:"disabled" validate:"@int[0,10]"`
+	}
+
+	typ := reflect.TypeOf(Data{})
+	v := NewJSONValueValidator(
+		ValidatorMgrDefault.MustCompile(ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(typ)),
+		typ,
+	)
+
+	t.Run("zero values are present", func(t *testing.T) {
+		err := v.Validate(map[string]interface{}{"count": float64(0), "disabled": float64(1)})
+		require.NoError(t, err)
+	})
+
+	t.Run("absent and null keys are missing", func(t *testing.T) {
+		err := v.Validate(map[string]interface{}{"count": nil, "disabled": float64(1)})
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			require.Equal(t, errors.MissingRequiredFieldError{}, fieldErr.Error)
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"count"}, keyPaths)
+	})
+}
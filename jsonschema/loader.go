@@ -0,0 +1,265 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+)
+
+// Load translates JSON Schema document to validator for values decoded by encoding/json,
+// formats are resolved from validator.ValidatorMgrDefault
+func Load(data []byte) (validator.Validator, error) {
+	return NewLoader(validator.ValidatorMgrDefault).Load(data)
+}
+
+func NewLoader(mgr validator.ValidatorMgr) *Loader {
+	return &Loader{Mgr: mgr}
+}
+
+type Loader struct {
+	// to resolve `format` of string as strfmt validator with same name,
+	// unknown formats will be ignored
+	Mgr validator.ValidatorMgr
+}
+
+func (l *Loader) Load(data []byte) (validator.Validator, error) {
+	schema := &Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	return l.New(schema)
+}
+
+// noDigitsLimit disables digits checking of validator.FloatValidator, JSON Schema has no such constraint
+const noDigitsLimit = uint(math.MaxUint32)
+
+var typString = typesutil.FromRType(reflect.TypeOf(""))
+
+func (l *Loader) New(schema *Schema) (validator.Validator, error) {
+	v := &SchemaValidator{
+		Types: schema.Type,
+	}
+
+	for _, t := range schema.Type {
+		switch t {
+		case TypeNull, TypeBoolean, TypeString, TypeNumber, TypeInteger, TypeArray, TypeObject:
+		default:
+			return nil, fmt.Errorf("unsupported type %s", t)
+		}
+	}
+
+	if err := l.resolveEnum(v, schema); err != nil {
+		return nil, err
+	}
+	if err := l.resolveString(v, schema); err != nil {
+		return nil, err
+	}
+	if err := l.resolveNumber(v, schema); err != nil {
+		return nil, err
+	}
+	if err := l.resolveArray(v, schema); err != nil {
+		return nil, err
+	}
+	if err := l.resolveObject(v, schema); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func (l *Loader) resolveEnum(v *SchemaValidator, schema *Schema) error {
+	if len(schema.Enum) == 0 {
+		return nil
+	}
+
+	enums := map[string]string{}
+	for _, e := range schema.Enum {
+		s, ok := e.(string)
+		if !ok {
+			// enum with values in other types
+			v.Enum = schema.Enum
+			return nil
+		}
+		enums[s] = s
+	}
+
+	v.StringValidators = append(v.StringValidators, &validator.StringValidator{Enums: enums})
+	if len(v.Types) == 0 {
+		v.Types = TypeList{TypeString}
+	}
+	return nil
+}
+
+func (l *Loader) resolveString(v *SchemaValidator, schema *Schema) error {
+	if schema.MinLength != nil || schema.MaxLength != nil {
+		// JSON Schema counts length by characters
+		stringValidator := &validator.StringValidator{
+			LenMode:   validator.STR_LEN_MODE__RUNE_COUNT,
+			MaxLength: schema.MaxLength,
+		}
+		if schema.MinLength != nil {
+			stringValidator.MinLength = *schema.MinLength
+		}
+		v.StringValidators = append(v.StringValidators, stringValidator)
+	}
+
+	if schema.Pattern != "" {
+		pattern, err := regexp.Compile(schema.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %s: %s", schema.Pattern, err)
+		}
+		v.StringValidators = append(v.StringValidators, &validator.StringValidator{Pattern: pattern})
+	}
+
+	if schema.Format != "" && l.Mgr != nil {
+		formatValidator, err := l.Mgr.Compile(context.Background(), []byte("@"+schema.Format), typString)
+		if err == nil {
+			v.StringValidators = append(v.StringValidators, formatValidator)
+		}
+	}
+
+	return nil
+}
+
+func (l *Loader) resolveNumber(v *SchemaValidator, schema *Schema) error {
+	minimum, exclusiveMinimum, err := exclusiveBound(schema.Minimum, schema.ExclusiveMinimum)
+	if err != nil {
+		return err
+	}
+	maximum, exclusiveMaximum, err := exclusiveBound(schema.Maximum, schema.ExclusiveMaximum)
+	if err != nil {
+		return err
+	}
+
+	if minimum == nil && maximum == nil && schema.MultipleOf == nil {
+		return nil
+	}
+
+	if schema.MultipleOf != nil && *schema.MultipleOf <= 0 {
+		return fmt.Errorf("multipleOf should be greater than 0, but got %v", *schema.MultipleOf)
+	}
+
+	if len(v.Types) == 1 && v.Types[0] == TypeInteger && isIntegral(minimum, maximum, schema.MultipleOf) {
+		intValidator := &validator.IntValidator{
+			BitSize:          64,
+			ExclusiveMinimum: exclusiveMinimum,
+			ExclusiveMaximum: exclusiveMaximum,
+		}
+		if minimum != nil {
+			n := int64(*minimum)
+			intValidator.Minimum = &n
+		}
+		if maximum != nil {
+			n := int64(*maximum)
+			intValidator.Maximum = &n
+		}
+		if schema.MultipleOf != nil {
+			intValidator.MultipleOf = int64(*schema.MultipleOf)
+		}
+		intValidator.SetDefaults()
+		v.IntegerValidator = intValidator
+		return nil
+	}
+
+	if minimum != nil || maximum != nil {
+		decimalDigits := noDigitsLimit
+		v.NumberValidators = append(v.NumberValidators, &validator.FloatValidator{
+			MaxDigits:        noDigitsLimit,
+			DecimalDigits:    &decimalDigits,
+			Minimum:          minimum,
+			Maximum:          maximum,
+			ExclusiveMinimum: exclusiveMinimum,
+			ExclusiveMaximum: exclusiveMaximum,
+		})
+	}
+
+	if schema.MultipleOf != nil {
+		v.NumberValidators = append(v.NumberValidators, &MultipleOfValidator{
+			MultipleOf: *schema.MultipleOf,
+		})
+	}
+
+	return nil
+}
+
+func isIntegral(values ...*float64) bool {
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if *v != math.Trunc(*v) || *v < math.MinInt64 || *v >= math.MaxInt64 {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *Loader) resolveArray(v *SchemaValidator, schema *Schema) error {
+	if schema.Items == nil && schema.MinItems == nil && schema.MaxItems == nil {
+		return nil
+	}
+
+	sliceValidator := &validator.SliceValidator{
+		MaxItems: schema.MaxItems,
+	}
+
+	if schema.MinItems != nil {
+		sliceValidator.MinItems = *schema.MinItems
+	}
+
+	if schema.Items != nil {
+		itemsValidator, err := l.New(schema.Items)
+		if err != nil {
+			return fmt.Errorf("items %s", err)
+		}
+		sliceValidator.ElemValidator = itemsValidator
+	}
+
+	v.ArrayValidator = sliceValidator
+	return nil
+}
+
+func (l *Loader) resolveObject(v *SchemaValidator, schema *Schema) error {
+	if schema.Properties == nil && schema.Required == nil && schema.AdditionalProperties == nil && schema.MinProperties == nil && schema.MaxProperties == nil {
+		return nil
+	}
+
+	objectValidator := &ObjectValidator{
+		Properties:           map[string]validator.Validator{},
+		Required:             schema.Required,
+		AdditionalProperties: true,
+		MaxProperties:        schema.MaxProperties,
+	}
+
+	if schema.MinProperties != nil {
+		objectValidator.MinProperties = *schema.MinProperties
+	}
+
+	for name := range schema.Properties {
+		propValidator, err := l.New(schema.Properties[name])
+		if err != nil {
+			return fmt.Errorf("property %s %s", name, err)
+		}
+		objectValidator.Properties[name] = propValidator
+	}
+
+	if additional := schema.AdditionalProperties; additional != nil {
+		objectValidator.AdditionalProperties = additional.Allows
+		if additional.Schema != nil {
+			additionalValidator, err := l.New(additional.Schema)
+			if err != nil {
+				return fmt.Errorf("additionalProperties %s", err)
+			}
+			objectValidator.AdditionalPropertiesValidator = additionalValidator
+		}
+	}
+
+	v.ObjectValidator = objectValidator
+	return nil
+}
@@ -0,0 +1,130 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var userSchema = `{
+	"type": "object",
+	"required": ["name", "age"],
+	"additionalProperties": false,
+	"properties": {
+		"name": { "type": "string", "minLength": 1, "maxLength": 5 },
+		"age": { "type": "integer", "minimum": 0, "exclusiveMaximum": 150 },
+		"role": { "enum": ["admin", "member"] },
+		"score": { "type": "number", "multipleOf": 0.5 },
+		"tags": { "type": "array", "minItems": 1, "items": { "type": "string", "pattern": "^[a-z]+$" } }
+	}
+}`
+
+func ExampleLoad() {
+	v, err := Load([]byte(userSchema))
+	if err != nil {
+		panic(err)
+	}
+
+	data := map[string]interface{}{}
+	_ = json.Unmarshal([]byte(`{"name":"","age":150.5,"role":"admin","score":0.3,"tags":["a","B"],"x":1}`), &data)
+
+	err = v.Validate(data)
+	err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+		fmt.Println(fieldErr.Field, fieldErr.Error)
+	})
+	// Output:
+	// age value should be integer, but got float64 150.5
+	// name string length should be larger or equal than 1, but got invalid value 0
+	// score float value should be multiple of 0.5, but got invalid value 0.3
+	// tags[1] string length ^[a-z]+$ not match B
+	// x unknown field
+}
+
+func TestLoad(t *testing.T) {
+	v, err := Load([]byte(userSchema))
+	require.NoError(t, err)
+
+	cases := []struct {
+		data  string
+		valid bool
+	}{
+		{`{"name":"a","age":1}`, true},
+		{`{"name":"a","age":149,"score":1.5,"tags":["x"]}`, true},
+		{`{"name":"a"}`, false},
+		{`{"name":"a","age":150}`, false},
+		{`{"name":"a","age":-1}`, false},
+		{`{"name":"a","age":1,"tags":[]}`, false},
+		{`{"name":"a","age":1,"role":"root"}`, false},
+		{`{"name":"abcdef","age":1}`, false},
+		{`{"name":1,"age":1}`, false},
+		{`[]`, false},
+		{`null`, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.data, func(t *testing.T) {
+			var data interface{}
+			require.NoError(t, json.Unmarshal([]byte(c.data), &data))
+			err := v.Validate(data)
+			if c.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	t.Run("json.Number", func(t *testing.T) {
+		v, err := Load([]byte(`{"type":["number","null"],"minimum":1,"exclusiveMinimum":true}`))
+		require.NoError(t, err)
+
+		require.NoError(t, v.Validate(nil))
+		require.NoError(t, v.Validate(json.Number("1.1")))
+		require.Error(t, v.Validate(json.Number("1")))
+		require.Error(t, v.Validate("1"))
+	})
+
+	t.Run("multipleOf in tolerance", func(t *testing.T) {
+		v, err := Load([]byte(`{"type":"number","multipleOf":0.1}`))
+		require.NoError(t, err)
+
+		require.NoError(t, v.Validate(0.1+0.2))
+		require.NoError(t, v.Validate(0.30000000000000004))
+		require.NoError(t, v.Validate(1e20))
+		require.NoError(t, v.Validate(-0.7))
+		require.Error(t, v.Validate(0.35))
+		require.Error(t, v.Validate(0.123456789))
+	})
+
+	t.Run("enum in other types", func(t *testing.T) {
+		v, err := Load([]byte(`{"enum":[1,"a",null]}`))
+		require.NoError(t, err)
+
+		require.NoError(t, v.Validate(float64(1)))
+		require.NoError(t, v.Validate(nil))
+		require.Error(t, v.Validate("b"))
+	})
+
+	t.Run("additionalProperties schema", func(t *testing.T) {
+		v, err := Load([]byte(`{"type":"object","maxProperties":2,"additionalProperties":{"type":"boolean"}}`))
+		require.NoError(t, err)
+
+		require.NoError(t, v.Validate(map[string]interface{}{"a": true}))
+		require.Error(t, v.Validate(map[string]interface{}{"a": 1.0}))
+		require.Error(t, v.Validate(map[string]interface{}{"a": true, "b": true, "c": true}))
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, err := Load([]byte(`{"type":"date"}`))
+		require.Error(t, err)
+
+		_, err = Load([]byte(`{"properties":{"a":{"pattern":"("}}}`))
+		require.Error(t, err)
+
+		_, err = Load([]byte(`{"exclusiveMinimum":"1"}`))
+		require.Error(t, err)
+	})
+}
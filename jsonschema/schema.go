@@ -0,0 +1,107 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+/*
+Schema is the supported subset of JSON Schema.
+
+	type: null, boolean, string, number, integer, array, object (or list of them)
+	string: minLength, maxLength, pattern, format, enum
+	number and integer: minimum, maximum, exclusiveMinimum, exclusiveMaximum (boolean of draft 4 or number of draft 6+), multipleOf, enum
+	array: items, minItems, maxItems
+	object: properties, required, additionalProperties (boolean or schema), minProperties, maxProperties
+*/
+type Schema struct {
+	Type TypeList      `json:"type,omitempty"`
+	Enum []interface{} `json:"enum,omitempty"`
+
+	MinLength *uint64 `json:"minLength,omitempty"`
+	MaxLength *uint64 `json:"maxLength,omitempty"`
+	Pattern   string  `json:"pattern,omitempty"`
+	Format    string  `json:"format,omitempty"`
+
+	Minimum          *float64        `json:"minimum,omitempty"`
+	Maximum          *float64        `json:"maximum,omitempty"`
+	ExclusiveMinimum json.RawMessage `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum json.RawMessage `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64        `json:"multipleOf,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MinItems *uint64 `json:"minItems,omitempty"`
+	MaxItems *uint64 `json:"maxItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *SchemaOrBool      `json:"additionalProperties,omitempty"`
+	MinProperties        *uint64            `json:"minProperties,omitempty"`
+	MaxProperties        *uint64            `json:"maxProperties,omitempty"`
+}
+
+// TypeList is `type` of schema, could be a string or list of strings
+type TypeList []string
+
+func (list *TypeList) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		s := ""
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*list = TypeList{s}
+		return nil
+	}
+	values := make([]string, 0)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*list = values
+	return nil
+}
+
+// SchemaOrBool for `additionalProperties`
+type SchemaOrBool struct {
+	Allows bool
+	Schema *Schema
+}
+
+func (s *SchemaOrBool) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch string(data) {
+	case "true":
+		s.Allows = true
+		return nil
+	case "false":
+		s.Allows = false
+		return nil
+	}
+	s.Allows = true
+	s.Schema = &Schema{}
+	return json.Unmarshal(data, s.Schema)
+}
+
+// exclusiveBound resolves exclusiveMinimum or exclusiveMaximum,
+// returns the bound and if it is exclusive.
+func exclusiveBound(bound *float64, exclusive json.RawMessage) (*float64, bool, error) {
+	exclusive = bytes.TrimSpace(exclusive)
+	if len(exclusive) == 0 {
+		return bound, false, nil
+	}
+
+	switch string(exclusive) {
+	case "true":
+		return bound, bound != nil, nil
+	case "false":
+		return bound, false, nil
+	}
+
+	n := float64(0)
+	if err := json.Unmarshal(exclusive, &n); err != nil {
+		return nil, false, fmt.Errorf("exclusive bound should be boolean or number, but got %s", exclusive)
+	}
+
+	return &n, true, nil
+}
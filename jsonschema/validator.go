@@ -0,0 +1,283 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+const (
+	TypeNull    = "null"
+	TypeBoolean = "boolean"
+	TypeString  = "string"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeArray   = "array"
+	TypeObject  = "object"
+)
+
+var TargetObjectProperties = "object properties"
+
+// SchemaValidator validates value decoded by encoding/json
+// (nil, bool, string, float64 or json.Number, []interface{}, map[string]interface{}).
+// Constraints for one JSON type will be skipped when value in other JSON types, as JSON Schema does.
+type SchemaValidator struct {
+	// allowed JSON types, empty means any
+	Types TypeList
+	// enum values when not all in string
+	Enum []interface{}
+
+	StringValidators []validator.Validator
+	NumberValidators []validator.Validator
+	IntegerValidator *validator.IntValidator
+	ArrayValidator   *validator.SliceValidator
+	ObjectValidator  *ObjectValidator
+}
+
+func (v *SchemaValidator) Validate(value interface{}) error {
+	value = jsonValueOf(value)
+	typ := typeOf(value)
+
+	if !v.allows(typ, value) {
+		return &errors.TypeMismatchError{
+			Expect:  strings.Join(v.Types, " or "),
+			Current: value,
+		}
+	}
+
+	if v.Enum != nil && !inEnum(value, v.Enum) {
+		return &errors.NotInEnumError{
+			Target:  "value",
+			Current: value,
+			Enums:   v.Enum,
+		}
+	}
+
+	switch typ {
+	case TypeString:
+		for i := range v.StringValidators {
+			if err := v.StringValidators[i].Validate(value); err != nil {
+				return err
+			}
+		}
+	case TypeNumber:
+		f := value.(float64)
+		if v.IntegerValidator != nil {
+			if f < math.MinInt64 || f >= math.MaxInt64 {
+				return &errors.OutOfRangeError{
+					Target:  validator.TargetIntValue,
+					Current: f,
+					Minimum: *v.IntegerValidator.Minimum,
+					Maximum: *v.IntegerValidator.Maximum,
+				}
+			}
+			return v.IntegerValidator.Validate(int64(f))
+		}
+		for i := range v.NumberValidators {
+			if err := v.NumberValidators[i].Validate(f); err != nil {
+				return err
+			}
+		}
+	case TypeArray:
+		if v.ArrayValidator != nil {
+			return v.ArrayValidator.Validate(value)
+		}
+	case TypeObject:
+		if v.ObjectValidator != nil {
+			return v.ObjectValidator.Validate(value)
+		}
+	}
+
+	return nil
+}
+
+func (v *SchemaValidator) allows(typ string, value interface{}) bool {
+	if len(v.Types) == 0 {
+		return true
+	}
+	for _, t := range v.Types {
+		if t == typ {
+			return true
+		}
+		if t == TypeInteger && typ == TypeNumber {
+			f := value.(float64)
+			if f == math.Trunc(f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *SchemaValidator) String() string {
+	return "@jsonschema"
+}
+
+// ObjectValidator validates map[string]interface{} like a struct.
+// errors are ordered by property names for deterministic.
+type ObjectValidator struct {
+	Properties map[string]validator.Validator
+	Required   []string
+	// when false, properties not declared in Properties will be reported as unknown field
+	AdditionalProperties bool
+	// validator for properties not declared in Properties
+	AdditionalPropertiesValidator validator.Validator
+
+	MinProperties uint64
+	MaxProperties *uint64
+}
+
+func (v *ObjectValidator) Validate(value interface{}) error {
+	object, ok := jsonValueOf(value).(map[string]interface{})
+	if !ok {
+		return &errors.TypeMismatchError{
+			Expect:  TypeObject,
+			Current: value,
+		}
+	}
+
+	n := uint64(len(object))
+	if n < v.MinProperties {
+		return &errors.OutOfRangeError{
+			Target:  TargetObjectProperties,
+			Current: n,
+			Minimum: v.MinProperties,
+		}
+	}
+	if v.MaxProperties != nil && n > *v.MaxProperties {
+		return &errors.OutOfRangeError{
+			Target:  TargetObjectProperties,
+			Current: n,
+			Maximum: *v.MaxProperties,
+		}
+	}
+
+	errSet := errors.NewErrorSet("")
+
+	for _, name := range v.Required {
+		if _, ok := object[name]; !ok {
+			errSet.AddErr(errors.MissingRequiredFieldError{}, name)
+		}
+	}
+
+	names := make([]string, 0, len(object))
+	for name := range object {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if propValidator, ok := v.Properties[name]; ok {
+			errSet.AddErr(propValidator.Validate(object[name]), name)
+			continue
+		}
+		if !v.AdditionalProperties {
+			errSet.AddErr(errors.UnknownFieldError{}, name)
+			continue
+		}
+		if v.AdditionalPropertiesValidator != nil {
+			errSet.AddErr(v.AdditionalPropertiesValidator.Validate(object[name]), name)
+		}
+	}
+
+	return errSet.Err()
+}
+
+func (v *ObjectValidator) String() string {
+	return "@object"
+}
+
+// multipleOfTolerance is the tolerance of quotient to be treated as integer,
+// for binary floating point errors like 0.1 + 0.2 = 0.30000000000000004
+const multipleOfTolerance = 1e-9
+
+// MultipleOfValidator validates float64 is multiple of MultipleOf in tolerance of floating point errors
+type MultipleOfValidator struct {
+	MultipleOf float64
+}
+
+func (v *MultipleOfValidator) Validate(value interface{}) error {
+	f, ok := jsonValueOf(value).(float64)
+	if !ok {
+		return &errors.TypeMismatchError{
+			Expect:  TypeNumber,
+			Current: value,
+		}
+	}
+
+	q := f / v.MultipleOf
+	if math.Abs(q-math.Round(q)) > multipleOfTolerance {
+		return &errors.MultipleOfError{
+			Target:     validator.TargetFloatValue,
+			Current:    f,
+			MultipleOf: v.MultipleOf,
+		}
+	}
+
+	return nil
+}
+
+func (v *MultipleOfValidator) String() string {
+	return "@multipleOf"
+}
+
+// jsonValueOf unwraps reflect.Value and normalizes json.Number
+func jsonValueOf(value interface{}) interface{} {
+	if rv, ok := value.(reflect.Value); ok {
+		if !rv.IsValid() {
+			return nil
+		}
+		if rv.Kind() == reflect.Interface {
+			if rv.IsNil() {
+				return nil
+			}
+			rv = rv.Elem()
+		}
+		value = rv.Interface()
+	}
+
+	switch x := value.(type) {
+	case json.Number:
+		if f, err := x.Float64(); err == nil {
+			return f
+		}
+	case int:
+		return float64(x)
+	case int64:
+		return float64(x)
+	}
+
+	return value
+}
+
+func typeOf(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case string:
+		return TypeString
+	case float64:
+		return TypeNumber
+	case []interface{}:
+		return TypeArray
+	case map[string]interface{}:
+		return TypeObject
+	}
+	return ""
+}
+
+func inEnum(value interface{}, enums []interface{}) bool {
+	for i := range enums {
+		if reflect.DeepEqual(value, enums[i]) {
+			return true
+		}
+	}
+	return false
+}
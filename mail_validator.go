@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"context"
+	"net/mail"
+	"reflect"
+	"strings"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/go-courier/validator/rules"
+)
+
+var (
+	TargetMailDomain = "mail domain"
+)
+
+/*
+Validator for mail address (RFC 5322), works for string and mail.Address
+
+Rules:
+
+	@mail
+	@mail{example.com,*.example.com} // domain should be one of them, `*.` for any sub domains
+*/
+type MailValidator struct {
+	Domains map[string]bool
+}
+
+func init() {
+	ValidatorMgrDefault.Register(&MailValidator{})
+}
+
+func (MailValidator) Names() []string {
+	return []string{"mail"}
+}
+
+func (validator *MailValidator) Validate(v interface{}) error {
+	address := ""
+
+	switch x := indirectValue(v).(type) {
+	case mail.Address:
+		address = x.Address
+	default:
+		s, err := stringOfTextValue(v, validator.String())
+		if err != nil {
+			return err
+		}
+		address = s
+	}
+
+	parsed, err := mail.ParseAddress(address)
+	if err != nil || parsed.Address != address {
+		return &errors.TypeMismatchError{Expect: "mail address", Current: address}
+	}
+
+	if len(validator.Domains) > 0 {
+		domain := strings.ToLower(address[strings.LastIndex(address, "@")+1:])
+		if !allowDomain(validator.Domains, domain) {
+			return &errors.NotInEnumError{
+				Target:  TargetMailDomain,
+				Current: domain,
+				Enums:   keysAsEnums(validator.Domains),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (MailValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
+	validator := &MailValidator{}
+
+	for _, v := range rule.ComputedValues() {
+		domain := strings.ToLower(string(v.Bytes()))
+		if domain == "" {
+			continue
+		}
+		if validator.Domains == nil {
+			validator.Domains = map[string]bool{}
+		}
+		validator.Domains[domain] = true
+	}
+
+	return validator, validator.TypeCheck(rule)
+}
+
+var rtypeMailAddress = reflect.TypeOf(mail.Address{})
+
+func (validator *MailValidator) TypeCheck(rule *Rule) error {
+	if rule.Type.Kind() == reflect.String || isRType(rule.Type, rtypeMailAddress) {
+		return nil
+	}
+	return errors.NewUnsupportedTypeError(rule.String(), validator.String())
+}
+
+func (validator *MailValidator) String() string {
+	rule := rules.NewRule(validator.Names()[0])
+
+	if len(validator.Domains) > 0 {
+		ruleValues := make([]*rules.RuleLit, 0, len(validator.Domains))
+		for _, domain := range sortedKeys(validator.Domains) {
+			ruleValues = append(ruleValues, rules.NewRuleLit([]byte(domain)))
+		}
+		rule.ValueMatrix = [][]*rules.RuleLit{ruleValues}
+	}
+
+	return string(rule.Bytes())
+}
+
+func (validator *MailValidator) Describe() *Description {
+	d := (&Description{Type: DescriptionTypeString}).add(&Constraint{
+		Code:   errors.CodeNotMatch,
+		Target: "mail",
+		Params: map[string]interface{}{"format": "mail"},
+	})
+
+	if len(validator.Domains) > 0 {
+		d.add(enumListConstraint(TargetMailDomain, sortedKeys(validator.Domains)))
+	}
+
+	return d
+}
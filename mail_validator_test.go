@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"context"
+	"net/mail"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailValidator(t *testing.T) {
+	cases := []struct {
+		rule    string
+		valid   []interface{}
+		invalid []interface{}
+	}{
+		{"@mail", []interface{}{"a@example.com", mail.Address{Name: "A", Address: "a@example.com"}, &mail.Address{Address: "b@example.com"}}, []interface{}{"a", "A <a@example.com>", "a@", mail.Address{Name: "A"}}},
+		{"@mail{example.com,*.example.org}", []interface{}{"a@example.com", "a@EXAMPLE.com", "a@mail.example.org"}, []interface{}{"a@example.org", "a@evil.com"}},
+	}
+
+	for _, c := range cases {
+		for _, v := range c.valid {
+			v := v
+			t.Run(c.rule+" valid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				require.NoError(t, validator.Validate(v))
+			})
+		}
+		for _, v := range c.invalid {
+			v := v
+			t.Run(c.rule+" invalid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				err := validator.Validate(v)
+				require.Error(t, err)
+				t.Log(err)
+			})
+		}
+	}
+
+	t.Run("string", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@mail{example.com}"), typesutil.FromRType(reflect.TypeOf("")))
+		require.Equal(t, "@mail{example.com}", v.String())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/go-courier/validator/errors"
 	"github.com/go-courier/validator/rules"
@@ -21,6 +22,10 @@ Rules:
 	@map<KEY_RULE, ELEM_RULE>[length]
 
 	@map<@string{A,B,C},@int[0]>[,100]
+
+Parallelism
+	entries will be validated concurrently when compiled with ContextWithParallelism or ValidatorFactory.SetParallelism,
+	errors are ordered by keys for deterministic.
 */
 type MapValidator struct {
 	MinProperties uint64
@@ -28,6 +33,11 @@ type MapValidator struct {
 
 	KeyValidator  Validator
 	ElemValidator Validator
+
+	// count of workers to validate entries concurrently
+	Parallelism int
+
+	observed bool
 }
 
 func init() {
@@ -48,6 +58,18 @@ func (validator *MapValidator) Validate(v interface{}) error {
 }
 
 func (validator *MapValidator) ValidateReflectValue(rv reflect.Value) error {
+	return validator.validateReflectValue(rv, rootScopeIf(validator.observed))
+}
+
+func (validator *MapValidator) validateInScope(v interface{}, s *scope) error {
+	rv, ok := v.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(v)
+	}
+	return validator.validateReflectValue(rv, s)
+}
+
+func (validator *MapValidator) validateReflectValue(rv reflect.Value, s *scope) error {
 	lenOfValue := uint64(0)
 	if !rv.IsNil() {
 		lenOfValue = uint64(rv.Len())
@@ -70,17 +92,30 @@ func (validator *MapValidator) ValidateReflectValue(rv reflect.Value) error {
 	}
 
 	if validator.KeyValidator != nil || validator.ElemValidator != nil {
+		if validator.Parallelism > 1 && rv.Len() > 1 {
+			return validator.validateEntriesConcurrently(rv, s)
+		}
+
 		errors := errors.NewErrorSet("")
 		for _, key := range rv.MapKeys() {
 			vOfKey := key.Interface()
 			if validator.KeyValidator != nil {
-				err := validator.KeyValidator.Validate(vOfKey)
+				var keyScope *scope
+				// key path only formatted when observed or failed
+				if s != nil {
+					keyScope = s.Child(fmt.Sprintf("%v/key", vOfKey))
+				}
+				err := validateInScope(validator.KeyValidator, vOfKey, keyScope)
 				if err != nil {
 					errors.AddErr(err, fmt.Sprintf("%v/key", vOfKey))
 				}
 			}
 			if validator.ElemValidator != nil {
-				err := validator.ElemValidator.Validate(rv.MapIndex(key).Interface())
+				var elemScope *scope
+				if s != nil {
+					elemScope = s.Child(fmt.Sprintf("%v", vOfKey))
+				}
+				err := validateInScope(validator.ElemValidator, rv.MapIndex(key).Interface(), elemScope)
 				if err != nil {
 					errors.AddErr(err, fmt.Sprintf("%v", vOfKey))
 				}
@@ -92,12 +127,63 @@ func (validator *MapValidator) ValidateReflectValue(rv reflect.Value) error {
 	return nil
 }
 
+func (validator *MapValidator) validateEntriesConcurrently(rv reflect.Value, s *scope) error {
+	keys := rv.MapKeys()
+	keyStrings := make([]string, len(keys))
+	for i := range keys {
+		keyStrings[i] = fmt.Sprintf("%v", keys[i].Interface())
+	}
+
+	sort.Sort(&mapKeys{keys: keys, keyStrings: keyStrings})
+
+	keyErrs := make([]error, len(keys))
+	elemErrs := make([]error, len(keys))
+
+	parallelEach(len(keys), validator.Parallelism, func(i int) {
+		if validator.KeyValidator != nil {
+			keyOfKey := keyStrings[i] + "/key"
+			keyErrs[i] = validateInScope(validator.KeyValidator, keys[i].Interface(), s.Child(keyOfKey))
+		}
+		if validator.ElemValidator != nil {
+			elemErrs[i] = validateInScope(validator.ElemValidator, rv.MapIndex(keys[i]).Interface(), s.Child(keyStrings[i]))
+		}
+	})
+
+	errSet := errors.NewErrorSet("")
+	for i := range keys {
+		errSet.AddErr(keyErrs[i], keyStrings[i]+"/key")
+		errSet.AddErr(elemErrs[i], keyStrings[i])
+	}
+	return errSet.Err()
+}
+
+type mapKeys struct {
+	keys       []reflect.Value
+	keyStrings []string
+}
+
+func (m *mapKeys) Len() int {
+	return len(m.keys)
+}
+
+func (m *mapKeys) Less(i, j int) bool {
+	return m.keyStrings[i] < m.keyStrings[j]
+}
+
+func (m *mapKeys) Swap(i, j int) {
+	m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	m.keyStrings[i], m.keyStrings[j] = m.keyStrings[j], m.keyStrings[i]
+}
+
 func (validator *MapValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
 	if rule.Type.Kind() != reflect.Map {
 		return nil, errors.NewUnsupportedTypeError(rule.String(), validator.String())
 	}
 
-	mapValidator := &MapValidator{}
+	mapValidator := &MapValidator{
+		Parallelism: ParallelismFromContext(ctx),
+		observed:    ValidateObserverFromContext(ctx) != nil,
+	}
 
 	if rule.ExclusiveLeft || rule.ExclusiveRight {
 		return nil, errors.NewSyntaxError("range mark of %s should not be `(` or `)`", mapValidator.Names()[0])
@@ -119,19 +205,20 @@ func (validator *MapValidator) New(ctx context.Context, rule *Rule) (Validator,
 		}
 
 		mgr := ValidatorMgrFromContext(ctx)
+		elemCtx := contextWithoutParallelism(ctx)
 
 		for i, param := range rule.Params {
 			switch r := param.(type) {
 			case *rules.Rule:
 				switch i {
 				case 0:
-					v, err := mgr.Compile(ctx, r.RAW, rule.Type.Key(), nil)
+					v, err := mgr.Compile(elemCtx, r.RAW, rule.Type.Key(), nil)
 					if err != nil {
 						return nil, fmt.Errorf("map key %s", err)
 					}
 					mapValidator.KeyValidator = v
 				case 1:
-					v, err := mgr.Compile(ctx, r.RAW, rule.Type.Elem(), nil)
+					v, err := mgr.Compile(elemCtx, r.RAW, rule.Type.Elem(), nil)
 					if err != nil {
 						return nil, fmt.Errorf("map elem %s", err)
 					}
@@ -144,7 +231,7 @@ func (validator *MapValidator) New(ctx context.Context, rule *Rule) (Validator,
 					return nil, fmt.Errorf("map parameter should be a valid rule")
 				}
 
-				v, err := mgr.Compile(ctx, raw, rule.Type.Elem(), nil)
+				v, err := mgr.Compile(elemCtx, raw, rule.Type.Elem(), nil)
 				if err != nil {
 					return nil, fmt.Errorf("map elem %s", err)
 				}
@@ -175,3 +262,11 @@ func (validator *MapValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *MapValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeMap}
+	d.add(lengthConstraint(TargetMapLength, validator.MinProperties, validator.MaxProperties))
+	d.Key = Describe(validator.KeyValidator)
+	d.Elem = Describe(validator.ElemValidator)
+	return d
+}
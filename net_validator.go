@@ -0,0 +1,352 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/go-courier/validator/rules"
+)
+
+var (
+	TargetIP           = "ip"
+	TargetPrefixLength = "prefix length"
+)
+
+const (
+	IPVersionAny = ""
+	IPVersion4   = "v4"
+	IPVersion6   = "v6"
+)
+
+/*
+Validator for IP address, works for string, net.IP and netip.Addr
+
+Rules:
+
+	@ip
+	@ip<v4> // should be IPv4 address
+	@ip<v6> // should be IPv6 address
+
+CIDR containment
+
+	@ip{10.0.0.0/8,192.168.0.0/16} // should be in one of the networks
+	@ip<v6>{fd00::/8}
+*/
+type IPValidator struct {
+	Version  string
+	Networks []*net.IPNet
+}
+
+func init() {
+	ValidatorMgrDefault.Register(&IPValidator{})
+}
+
+func (IPValidator) Names() []string {
+	return []string{"ip"}
+}
+
+func (validator *IPValidator) Validate(v interface{}) error {
+	s, err := stringOfTextValue(v, validator.String())
+	if err != nil {
+		return err
+	}
+
+	// zone of IPv6 address is not a part of address
+	if i := strings.LastIndex(s, "%"); i > 0 {
+		s = s[:i]
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return &errors.TypeMismatchError{Expect: "IP address", Current: s}
+	}
+
+	switch validator.Version {
+	case IPVersion4:
+		if ip.To4() == nil || strings.Contains(s, ":") {
+			return &errors.TypeMismatchError{Expect: "IPv4 address", Current: s}
+		}
+	case IPVersion6:
+		if !strings.Contains(s, ":") {
+			return &errors.TypeMismatchError{Expect: "IPv6 address", Current: s}
+		}
+	}
+
+	if len(validator.Networks) > 0 {
+		for _, n := range validator.Networks {
+			if n.Contains(ip) {
+				return nil
+			}
+		}
+		return &errors.NotInEnumError{
+			Target:  TargetIP,
+			Current: s,
+			Enums:   networksAsEnums(validator.Networks),
+		}
+	}
+
+	return nil
+}
+
+func (IPValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
+	validator := &IPValidator{}
+
+	if rule.Params != nil {
+		if len(rule.Params) != 1 {
+			return nil, fmt.Errorf("ip should only 1 parameter, but got %d", len(rule.Params))
+		}
+		version, err := parseIPVersion(string(rule.Params[0].Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		validator.Version = version
+	}
+
+	for _, v := range rule.ComputedValues() {
+		_, n, err := net.ParseCIDR(string(v.Bytes()))
+		if err != nil {
+			return nil, errors.NewSyntaxError("invalid CIDR `%s` of ip", v.Bytes())
+		}
+		isV4 := n.IP.To4() != nil
+		if (validator.Version == IPVersion4 && !isV4) || (validator.Version == IPVersion6 && isV4) {
+			return nil, errors.NewSyntaxError("CIDR `%s` not match ip version %s", v.Bytes(), validator.Version)
+		}
+		validator.Networks = append(validator.Networks, n)
+	}
+
+	return validator, validator.TypeCheck(rule)
+}
+
+func parseIPVersion(s string) (string, error) {
+	switch strings.ToLower(s) {
+	case "v4", "4", "ipv4":
+		return IPVersion4, nil
+	case "v6", "6", "ipv6":
+		return IPVersion6, nil
+	case "":
+		return IPVersionAny, nil
+	default:
+		return "", fmt.Errorf("unsupported ip version %s", s)
+	}
+}
+
+func (validator *IPValidator) TypeCheck(rule *Rule) error {
+	if rule.Type.Kind() == reflect.String {
+		return nil
+	}
+	return errors.NewUnsupportedTypeError(rule.String(), validator.String())
+}
+
+func (validator *IPValidator) String() string {
+	rule := rules.NewRule(validator.Names()[0])
+
+	if validator.Version != IPVersionAny {
+		rule.Params = []rules.RuleNode{
+			rules.NewRuleLit([]byte(validator.Version)),
+		}
+	}
+
+	if len(validator.Networks) > 0 {
+		ruleValues := make([]*rules.RuleLit, 0, len(validator.Networks))
+		for _, n := range validator.Networks {
+			ruleValues = append(ruleValues, rules.NewRuleLit([]byte(n.String())))
+		}
+		rule.ValueMatrix = [][]*rules.RuleLit{ruleValues}
+	}
+
+	return string(rule.Bytes())
+}
+
+func (validator *IPValidator) Describe() *Description {
+	format := "ip"
+	if validator.Version != IPVersionAny {
+		format = "ip" + validator.Version
+	}
+
+	d := (&Description{Type: DescriptionTypeString}).add(&Constraint{
+		Code:   errors.CodeNotMatch,
+		Target: TargetIP,
+		Params: map[string]interface{}{"format": format},
+	})
+
+	if len(validator.Networks) > 0 {
+		d.add(enumListConstraint(TargetIP, networksAsStrings(validator.Networks)))
+	}
+
+	return d
+}
+
+func networksAsStrings(networks []*net.IPNet) []string {
+	values := make([]string, 0, len(networks))
+	for _, n := range networks {
+		values = append(values, n.String())
+	}
+	return values
+}
+
+func networksAsEnums(networks []*net.IPNet) []interface{} {
+	values := make([]interface{}, 0, len(networks))
+	for _, n := range networks {
+		values = append(values, n.String())
+	}
+	return values
+}
+
+/*
+Validator for network prefix, works for string, *net.IPNet and netip.Prefix
+
+Rules:
+
+	@prefix
+	@prefix<v4>
+	@prefix<v6>
+
+ranges of prefix length
+
+	@prefix[8,24] // prefix length should large or equal than 8 and less or equal than 24
+	@prefix[24] // prefix length should be equal 24
+*/
+type PrefixValidator struct {
+	Version string
+
+	MinBits uint64
+	MaxBits *uint64
+}
+
+func init() {
+	ValidatorMgrDefault.Register(&PrefixValidator{})
+}
+
+func (PrefixValidator) Names() []string {
+	return []string{"prefix", "cidr"}
+}
+
+func (validator *PrefixValidator) Validate(v interface{}) error {
+	if ipNet, ok := indirectValue(v).(net.IPNet); ok {
+		v = ipNet.String()
+	}
+
+	s, err := stringOfTextValue(v, validator.String())
+	if err != nil {
+		return err
+	}
+
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return &errors.TypeMismatchError{Expect: "network prefix", Current: s}
+	}
+
+	switch validator.Version {
+	case IPVersion4:
+		if ip.To4() == nil || strings.Contains(s, ":") {
+			return &errors.TypeMismatchError{Expect: "IPv4 network prefix", Current: s}
+		}
+	case IPVersion6:
+		if !strings.Contains(s, ":") {
+			return &errors.TypeMismatchError{Expect: "IPv6 network prefix", Current: s}
+		}
+	}
+
+	ones, _ := n.Mask.Size()
+	bits := uint64(ones)
+
+	if bits < validator.MinBits {
+		return &errors.OutOfRangeError{
+			Target:  TargetPrefixLength,
+			Current: bits,
+			Minimum: validator.MinBits,
+		}
+	}
+
+	if validator.MaxBits != nil && bits > *validator.MaxBits {
+		return &errors.OutOfRangeError{
+			Target:  TargetPrefixLength,
+			Current: bits,
+			Maximum: validator.MaxBits,
+		}
+	}
+
+	return nil
+}
+
+func (PrefixValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
+	validator := &PrefixValidator{}
+
+	if rule.ExclusiveLeft || rule.ExclusiveRight {
+		return nil, errors.NewSyntaxError("range mark of %s should not be `(` or `)`", validator.Names()[0])
+	}
+
+	if rule.Params != nil {
+		if len(rule.Params) != 1 {
+			return nil, fmt.Errorf("prefix should only 1 parameter, but got %d", len(rule.Params))
+		}
+		version, err := parseIPVersion(string(rule.Params[0].Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		validator.Version = version
+	}
+
+	if rule.Range != nil {
+		min, max, err := UintRange("prefix length", 8, rule.Range...)
+		if err != nil {
+			return nil, err
+		}
+
+		maxBits := uint64(128)
+		if validator.Version == IPVersion4 {
+			maxBits = 32
+		}
+		if min > maxBits || (max != nil && *max > maxBits) {
+			return nil, fmt.Errorf("prefix length should be less than %d", maxBits)
+		}
+
+		validator.MinBits = min
+		validator.MaxBits = max
+	}
+
+	return validator, validator.TypeCheck(rule)
+}
+
+var rtypeIPNet = reflect.TypeOf(net.IPNet{})
+
+func (validator *PrefixValidator) TypeCheck(rule *Rule) error {
+	if rule.Type.Kind() == reflect.String || isRType(rule.Type, rtypeIPNet) {
+		return nil
+	}
+	return errors.NewUnsupportedTypeError(rule.String(), validator.String())
+}
+
+func (validator *PrefixValidator) String() string {
+	rule := rules.NewRule(validator.Names()[0])
+
+	if validator.Version != IPVersionAny {
+		rule.Params = []rules.RuleNode{
+			rules.NewRuleLit([]byte(validator.Version)),
+		}
+	}
+
+	rule.Range = RangeFromUint(validator.MinBits, validator.MaxBits)
+
+	return string(rule.Bytes())
+}
+
+func (validator *PrefixValidator) Describe() *Description {
+	format := "cidr"
+	if validator.Version != IPVersionAny {
+		format = "cidr" + validator.Version
+	}
+
+	return (&Description{Type: DescriptionTypeString}).add(
+		&Constraint{
+			Code:   errors.CodeNotMatch,
+			Target: TargetPrefixLength,
+			Params: map[string]interface{}{"format": format},
+		},
+		lengthConstraint(TargetPrefixLength, validator.MinBits, validator.MaxBits),
+	)
+}
@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPValidator(t *testing.T) {
+	cases := []struct {
+		rule    string
+		valid   []interface{}
+		invalid []interface{}
+	}{
+		{"@ip", []interface{}{"1.2.3.4", "::1", net.ParseIP("10.0.0.1"), netip.MustParseAddr("fe80::1%eth0")}, []interface{}{"1.2.3", "host", net.IP{}}},
+		{"@ip<v4>", []interface{}{"1.2.3.4", net.ParseIP("1.2.3.4"), netip.MustParseAddr("1.2.3.4")}, []interface{}{"::1", "::ffff:1.2.3.4", netip.MustParseAddr("::1")}},
+		{"@ip<v6>", []interface{}{"::1", "::ffff:1.2.3.4", netip.MustParseAddr("fd00::1")}, []interface{}{"1.2.3.4", net.ParseIP("1.2.3.4")}},
+		{"@ip{10.0.0.0/8,192.168.0.0/16}", []interface{}{"10.1.2.3", net.ParseIP("192.168.1.1")}, []interface{}{"11.0.0.1", "fd00::1"}},
+		{"@ip<v6>{fd00::/8}", []interface{}{"fd00::1", netip.MustParseAddr("fdff::1")}, []interface{}{"fe80::1", "10.0.0.1"}},
+	}
+
+	for _, c := range cases {
+		for _, v := range c.valid {
+			v := v
+			t.Run(c.rule+" valid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				require.NoError(t, validator.Validate(v))
+			})
+		}
+		for _, v := range c.invalid {
+			v := v
+			t.Run(c.rule+" invalid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				err := validator.Validate(v)
+				require.Error(t, err)
+				t.Log(err)
+			})
+		}
+	}
+
+	t.Run("string", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@ip<v4>{10.0.0.0/8, 192.168.0.0/16}"), typesutil.FromRType(reflect.TypeOf("")))
+		require.Equal(t, "@ip<v4>{10.0.0.0/8,192.168.0.0/16}", v.String())
+		require.Equal(t, "string in ipv4 format, one of 10.0.0.0/8, 192.168.0.0/16", Describe(v).String())
+	})
+
+	t.Run("new failed", func(t *testing.T) {
+		for _, rule := range []string{"@ip<v5>", "@ip{10.0.0.0}", "@ip<v4>{fd00::/8}", "@ip<v4,v6>"} {
+			_, err := ValidatorMgrDefault.Compile(context.Background(), []byte(rule), typesutil.FromRType(reflect.TypeOf("")))
+			require.Error(t, err, rule)
+		}
+		_, err := ValidatorMgrDefault.Compile(context.Background(), []byte("@ip"), typesutil.FromRType(reflect.TypeOf(1)))
+		require.Error(t, err)
+	})
+}
+
+func TestPrefixValidator(t *testing.T) {
+	cases := []struct {
+		rule    string
+		valid   []interface{}
+		invalid []interface{}
+	}{
+		{"@prefix", []interface{}{"10.0.0.0/8", netip.MustParsePrefix("fd00::/8")}, []interface{}{"10.0.0.0", "10.0.0.0/33"}},
+		{"@prefix[8,24]", []interface{}{"10.0.0.0/8", netip.MustParsePrefix("192.168.1.0/24"), mustParseCIDR("10.0.0.0/16")}, []interface{}{"10.0.0.0/25", netip.MustParsePrefix("0.0.0.0/0"), mustParseCIDR("10.0.0.0/32")}},
+		{"@prefix<v6>[,64]", []interface{}{"fd00::/8", netip.MustParsePrefix("2001:db8::/64")}, []interface{}{"10.0.0.0/8", "2001:db8::/96"}},
+	}
+
+	for _, c := range cases {
+		for _, v := range c.valid {
+			v := v
+			t.Run(c.rule+" valid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				require.NoError(t, validator.Validate(v))
+			})
+		}
+		for _, v := range c.invalid {
+			v := v
+			t.Run(c.rule+" invalid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				err := validator.Validate(v)
+				require.Error(t, err)
+				t.Log(err)
+			})
+		}
+	}
+
+	t.Run("string", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@prefix<v4>[8,24]"), typesutil.FromRType(reflect.TypeOf("")))
+		require.Equal(t, "@prefix<v4>[8,24]", v.String())
+	})
+
+	t.Run("new failed", func(t *testing.T) {
+		for _, rule := range []string{"@prefix<v4>[8,33]", "@prefix[,129]", "@prefix(8,24)", "@prefix[24,8]"} {
+			_, err := ValidatorMgrDefault.Compile(context.Background(), []byte(rule), typesutil.FromRType(reflect.TypeOf("")))
+			require.Error(t, err, rule)
+		}
+	})
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
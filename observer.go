@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-courier/validator/errors"
+)
+
+// ValidateEvent describes an evaluation of compiled rule
+type ValidateEvent struct {
+	// name of validator, the first one of Names()
+	Validator string
+	// key path of value from the root value
+	KeyPath errors.KeyPath
+	// time cost of evaluation, including nested values
+	Duration time.Duration
+	// nil when passed
+	Error error
+}
+
+// ValidateObserver will be called for each compiled rule evaluation.
+// It should be safe for concurrent use.
+type ValidateObserver interface {
+	OnValidate(event *ValidateEvent)
+}
+
+type ValidateObserverFunc func(event *ValidateEvent)
+
+func (fn ValidateObserverFunc) OnValidate(event *ValidateEvent) {
+	fn(event)
+}
+
+type contextKeyValidateObserver int
+
+func ContextWithValidateObserver(ctx context.Context, observer ValidateObserver) context.Context {
+	return context.WithValue(ctx, contextKeyValidateObserver(1), observer)
+}
+
+func ValidateObserverFromContext(ctx context.Context) ValidateObserver {
+	if observer, ok := ctx.Value(contextKeyValidateObserver(1)).(ValidateObserver); ok {
+		return observer
+	}
+	return nil
+}
+
+// scope tracks the key path of value under validating.
+// nil scope means nothing need to track, and should cost nothing.
+type scope struct {
+	keyPath errors.KeyPath
+	// depth of nested values of recursive struct types
+	depth int
+}
+
+func (s *scope) KeyPath() errors.KeyPath {
+	if s == nil {
+		return nil
+	}
+	return s.keyPath
+}
+
+func (s *scope) Child(keyOrIndex interface{}) *scope {
+	if s == nil {
+		return nil
+	}
+	keyPath := make(errors.KeyPath, len(s.keyPath), len(s.keyPath)+1)
+	copy(keyPath, s.keyPath)
+	return &scope{keyPath: append(keyPath, keyOrIndex), depth: s.depth}
+}
+
+func (s *scope) Depth() int {
+	if s == nil {
+		return 0
+	}
+	return s.depth
+}
+
+func (s *scope) Deeper() *scope {
+	if s == nil {
+		return nil
+	}
+	return &scope{keyPath: s.keyPath, depth: s.depth + 1}
+}
+
+type scopedValidator interface {
+	validateInScope(v interface{}, s *scope) error
+}
+
+func validateInScope(validator Validator, v interface{}, s *scope) error {
+	if s != nil {
+		if scoped, ok := validator.(scopedValidator); ok {
+			return scoped.validateInScope(v, s)
+		}
+	}
+	return validator.Validate(v)
+}
+
+func rootScopeIf(observed bool) *scope {
+	if observed {
+		return &scope{}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package validator_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/validatortest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateObserver(t *testing.T) {
+	type Sub struct {
+		Name string `validate:"@string[1,]"`
+	}
+
+	type SomeStruct struct {
+		Int   int               `validate:"@int[1,10]"`
+		Char  string            `validate:"@char[1,2]"`
+		Slice []Sub             `validate:"@slice[1,]"`
+		Map   map[string]string `validate:"@map<@string[2,],@string[1,]>"`
+	}
+
+	value := SomeStruct{
+		Int:   11,
+		Char:  "abc",
+		Slice: []Sub{{Name: "a"}, {}},
+		Map:   map[string]string{"k": "v"},
+	}
+
+	t.Run("registered on factory", func(t *testing.T) {
+		observer := &validatortest.Recorder{}
+
+		f := validator.NewValidatorFactory()
+		f.Register(&validator.StringValidator{}, &validator.IntValidator{}, &validator.StructValidator{}, &validator.SliceValidator{}, &validator.MapValidator{})
+		f.SetObserver(observer)
+
+		v := f.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(value)))
+		require.Error(t, v.Validate(value))
+
+		require.Equal(t, []string{
+			" @struct",
+			"Char @string",
+			"Int @int",
+			"Map @map",
+			"Map.k/key @string",
+			"Slice @slice",
+			"Slice[1] @struct",
+			"Slice[1].Name @string",
+		}, observer.Failed())
+	})
+
+	t.Run("passed via context", func(t *testing.T) {
+		observer := &validatortest.Recorder{}
+
+		v := validator.ValidatorMgrDefault.MustCompile(validator.ContextWithValidateObserver(context.Background(), observer), nil, typesutil.FromRType(reflect.TypeOf(value)))
+		require.Error(t, v.Validate(value))
+		require.Len(t, observer.Failed(), 8)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		observer := &validatortest.Recorder{}
+
+		f := validator.NewValidatorFactory()
+		f.Register(&validator.StringValidator{}, &validator.IntValidator{}, &validator.StructValidator{}, &validator.SliceValidator{}, &validator.MapValidator{})
+		f.SetObserver(observer)
+		f.SetObserver(nil)
+
+		v := f.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(value)))
+		require.Error(t, v.Validate(value))
+		require.Empty(t, observer.Events())
+	})
+}
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type contextKeyParallelism int
+
+// ContextWithParallelism enables worker-pool mode for elements of slice and map validators compiled with the ctx,
+// elements will be validated concurrently by the count of workers when workers > 1.
+// Only the outermost slice or map will be in worker-pool mode, nested ones of its elements will not start other pools.
+func ContextWithParallelism(ctx context.Context, workers int) context.Context {
+	return context.WithValue(ctx, contextKeyParallelism(1), workers)
+}
+
+func ParallelismFromContext(ctx context.Context) int {
+	if workers, ok := ctx.Value(contextKeyParallelism(1)).(int); ok {
+		return workers
+	}
+	return 0
+}
+
+func isParallelismSet(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKeyParallelism(1)).(int)
+	return ok
+}
+
+// contextWithoutParallelism is for elements of slice and map in worker-pool mode
+func contextWithoutParallelism(ctx context.Context) context.Context {
+	if ParallelismFromContext(ctx) == 0 {
+		return ctx
+	}
+	return ContextWithParallelism(ctx, 0)
+}
+
+// parallelEach calls fn for each index in [0, n) by workers
+func parallelEach(n int, workers int, fn func(i int)) {
+	if workers > n {
+		workers = n
+	}
+
+	next := int64(-1)
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelValidate(t *testing.T) {
+	type Item struct {
+		Name  string `validate:"@string[1,]"`
+		Count int    `validate:"@int[1,]"`
+	}
+
+	type Batch struct {
+		Items []Item          `validate:"@slice<@struct>[1,]"`
+		Index map[string]Item `validate:"@map<@string[2,],>"`
+	}
+
+	batch := &Batch{
+		Items: make([]Item, 1000),
+		Index: map[string]Item{},
+	}
+
+	for i := range batch.Items {
+		if i%3 != 0 {
+			batch.Items[i].Name = "name"
+		}
+		batch.Items[i].Count = i%5 + 1
+		batch.Index[fmt.Sprintf("k%d", i)] = batch.Items[i]
+	}
+	batch.Index["k"] = Item{Name: "name", Count: 1}
+
+	typ := typesutil.FromRType(reflect.TypeOf(batch))
+
+	serial := ValidatorMgrDefault.MustCompile(context.Background(), nil, typ)
+	parallel := ValidatorMgrDefault.MustCompile(ContextWithParallelism(context.Background(), 8), nil, typ)
+
+	require.Equal(t, 8, parallel.(*ValidatorLoader).Validator.(*StructValidator).fieldValidators["Items"].(*ValidatorLoader).Validator.(*SliceValidator).Parallelism)
+
+	keyPathsOf := func(err error) []string {
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		return keyPaths
+	}
+
+	errSerial := serial.Validate(batch)
+	require.Error(t, errSerial)
+
+	for i := 0; i < 5; i++ {
+		errParallel := parallel.Validate(batch)
+		require.Error(t, errParallel)
+		require.Equal(t, errSerial.(*errors.ErrorSet).Len(), errParallel.(*errors.ErrorSet).Len())
+
+		keyPaths := keyPathsOf(errParallel)
+
+		require.Equal(t, "Items[0].Name", keyPaths[0])
+		require.Equal(t, "Items[3].Name", keyPaths[1])
+		// ordered by map key
+		require.Equal(t, "Index.k/key", keyPaths[334])
+		require.Equal(t, "Index.k0.Name", keyPaths[335])
+	}
+
+	t.Run("factory option", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&StringValidator{}, &IntValidator{}, &StructValidator{}, &SliceValidator{}, &MapValidator{})
+		f.SetParallelism(4)
+
+		v := f.MustCompile(context.Background(), []byte("@slice<@string[1,]>"), typesutil.FromRType(reflect.TypeOf([]string{})))
+		require.Equal(t, 4, v.(*ValidatorLoader).Validator.(*SliceValidator).Parallelism)
+
+		err := v.Validate([]string{"a", "", "b", ""})
+		require.Equal(t, []string{"[1]", "[3]"}, keyPathsOf(err))
+	})
+	t.Run("only outermost collection", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&StringValidator{}, &SliceValidator{}, &MapValidator{})
+		f.SetParallelism(4)
+
+		v := f.MustCompile(context.Background(), []byte("@slice<@map<,@slice<@string[1,]>>>"), typesutil.FromRType(reflect.TypeOf([]map[string][]string{})))
+
+		sliceValidator := v.(*ValidatorLoader).Validator.(*SliceValidator)
+		require.Equal(t, 4, sliceValidator.Parallelism)
+
+		mapValidator := sliceValidator.ElemValidator.(*ValidatorLoader).Validator.(*MapValidator)
+		require.Equal(t, 0, mapValidator.Parallelism)
+		require.Equal(t, 0, mapValidator.ElemValidator.(*ValidatorLoader).Validator.(*SliceValidator).Parallelism)
+
+		err := v.Validate([]map[string][]string{{"a": {"x", ""}}, {"b": {""}}})
+		require.Equal(t, []string{"[0].a[1]", "[1].b[0]"}, keyPathsOf(err))
+	})
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-courier/reflectx"
+)
+
+type contextKeyPresenceMode int
+
+// ContextWithPresenceMode enables presence mode for rules compiled with the ctx.
+// In presence mode, only nil pointers, maps, slices and interfaces are missing,
+// zero values will be validated against the rule instead of short-circuiting.
+// Presence mode could be enabled by rule too, like `@int[0,10]!`.
+// Default values will only be applied to nil values in presence mode.
+func ContextWithPresenceMode(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, contextKeyPresenceMode(1), enabled)
+}
+
+func PresenceModeFromContext(ctx context.Context) bool {
+	enabled, _ := ctx.Value(contextKeyPresenceMode(1)).(bool)
+	return enabled
+}
+
+func isPresenceModeSet(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKeyPresenceMode(1)).(bool)
+	return ok
+}
+
+func isMissing(rv reflect.Value, presence bool) bool {
+	if !presence {
+		return reflectx.IsEmptyValue(rv)
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPresenceMode(t *testing.T) {
+	ptrInt := func(i int) *int { return &i }
+
+	t.Run("by rule", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int[0,10]!"), typesutil.FromRType(reflect.TypeOf(0)))
+		require.Equal(t, "@int<32>[0,10]!", v.String())
+		require.NoError(t, v.Validate(0))
+		require.Error(t, v.Validate(11))
+	})
+
+	t.Run("zero value validated against rule", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int[1,10]!"), typesutil.FromRType(reflect.TypeOf(0)))
+		require.IsType(t, &errors.OutOfRangeError{}, v.Validate(0))
+	})
+
+	t.Run("nil pointer is missing", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int[0,10]!"), typesutil.FromRType(reflect.TypeOf(ptrInt(0))))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate((*int)(nil)))
+		require.NoError(t, v.Validate(ptrInt(0)))
+	})
+
+	t.Run("by factory", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&IntValidator{})
+		f.SetPresenceMode(true)
+
+		v := f.MustCompile(context.Background(), []byte("@int[0,10]"), typesutil.FromRType(reflect.TypeOf(0)))
+		require.True(t, v.(*ValidatorLoader).Presence)
+		require.NoError(t, v.Validate(0))
+
+		v = f.MustCompile(ContextWithPresenceMode(context.Background(), false), []byte("@int[0,10]"), typesutil.FromRType(reflect.TypeOf(0)))
+		require.False(t, v.(*ValidatorLoader).Presence)
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(0))
+	})
+
+	t.Run("by context", func(t *testing.T) {
+		type Data struct {
+			Count int      `validate:"@int[0,10]"`
+			Flags []string `validate:"@slice<@string[0,]>[0,]"`
+			Sub   *struct{ A int }
+		}
+
+		v := ValidatorMgrDefault.MustCompile(ContextWithPresenceMode(context.Background(), true), nil, typesutil.FromRType(reflect.TypeOf(Data{})))
+
+		err := v.Validate(Data{Flags: []string{""}})
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"Sub"}, keyPaths)
+	})
+}
@@ -0,0 +1,152 @@
+package queryvalidate
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+const TagName = "name"
+
+var defaultBinder = NewBinder(TagName)
+
+// Bind decodes values into struct which ptr points to by tag `name`, and validates it
+func Bind(values url.Values, ptr interface{}) error {
+	return defaultBinder.Bind(values, ptr)
+}
+
+// NewBinder creates Binder with tagKey for naming query params, `name` will be used when empty
+func NewBinder(tagKey string) *Binder {
+	if tagKey == "" {
+		tagKey = TagName
+	}
+	return &Binder{
+		tagKey: tagKey,
+	}
+}
+
+/*
+Binder decodes url.Values into struct and validates it by the compiled StructValidator.
+
+	type Query struct {
+		Keyword string   `name:"keyword" validate:"@string[1,]"`
+		Size    int      `name:"size,omitempty" validate:"@int[1,100]" default:"10"`
+		Tags    []string `name:"tag,omitempty"` // ?tag=a&tag=b
+	}
+
+Conversion failures and rule failures will be returned together in one errors.ErrorSet keyed by query param name.
+*/
+type Binder struct {
+	tagKey string
+	// compiled validators by reflect.Type
+	validators sync.Map
+}
+
+func (b *Binder) Bind(values url.Values, ptr interface{}) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("query should be bound to pointer of struct, but got %T", ptr)
+	}
+	rv = rv.Elem()
+
+	v, err := b.validatorOf(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	errSet := errors.NewErrorSet("")
+	// key paths failed to decode, rule errors under them should be ignored
+	failed := make([]errors.KeyPath, 0)
+
+	b.decode(rv, values, func(err error, name string, keyPathNodes ...interface{}) {
+		keyPath := append(errors.KeyPath{name}, keyPathNodes...)
+		failed = append(failed, keyPath)
+		errSet.AddErr(err, keyPath...)
+	})
+
+	errSet.Merge(v.Validate(rv), nil, failed...)
+
+	return errSet.Err()
+}
+
+func (b *Binder) validatorOf(typ reflect.Type) (validator.Validator, error) {
+	if v, ok := b.validators.Load(typ); ok {
+		return v.(validator.Validator), nil
+	}
+
+	ctx := validator.ContextWithNamedTagKey(context.Background(), b.tagKey)
+
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return nil, err
+	}
+
+	b.validators.Store(typ, v)
+	return v, nil
+}
+
+func (b *Binder) decode(rv reflect.Value, values url.Values, onErr func(err error, name string, keyPathNodes ...interface{})) {
+	typ := rv.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, _, exists := typesutil.FieldDisplayName(field.Tag, b.tagKey, field.Name)
+		if !ast.IsExported(field.Name) || name == "-" {
+			continue
+		}
+
+		fieldValue := rv.Field(i)
+
+		if field.Anonymous && !exists && reflectx.Deref(field.Type).Kind() == reflect.Struct {
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					fieldValue.Set(reflect.New(field.Type.Elem()))
+				}
+				fieldValue = fieldValue.Elem()
+			}
+			b.decode(fieldValue, values, onErr)
+			continue
+		}
+
+		list, ok := values[name]
+		if !ok || len(list) == 0 {
+			continue
+		}
+
+		if isMultiple(field.Type) {
+			elems := reflect.MakeSlice(field.Type, len(list), len(list))
+			for i := range list {
+				if err := reflectx.UnmarshalText(elems.Index(i), []byte(list[i])); err != nil {
+					onErr(err, name, i)
+				}
+			}
+			fieldValue.Set(elems)
+			continue
+		}
+
+		if list[0] == "" {
+			continue
+		}
+
+		if err := reflectx.UnmarshalText(fieldValue, []byte(list[0])); err != nil {
+			onErr(err, name)
+		}
+	}
+}
+
+func isMultiple(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Slice || reflectx.IsBytes(typ) {
+		return false
+	}
+	_, ok := typesutil.EncodingTextMarshalerTypeReplacer(typesutil.FromRType(typ))
+	return !ok
+}
@@ -0,0 +1,68 @@
+package queryvalidate
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Pager struct {
+	Offset int `name:"offset,omitempty" validate:"@int[0,]"`
+	Size   int `name:"size,omitempty" validate:"@int[1,100]" default:"10"`
+}
+
+type Query struct {
+	Keyword string   `name:"keyword" validate:"@string[1,]"`
+	IDs     []uint64 `name:"id,omitempty" validate:"@slice<@uint64[1,]>[,3]"`
+	Tags    []string `name:"tag,omitempty"`
+	Pager
+}
+
+func ExampleBind() {
+	values, _ := url.ParseQuery("id=1&id=x&id=0&size=1000")
+
+	q := &Query{}
+	fmt.Println(Bind(values, q))
+	// Output:
+	// id[1] cannot set value `x`: strconv.ParseUint: parsing "x": invalid syntax
+	// keyword missing required field
+	// id[2] missing required field
+	// size int value should be larger or equal than 1 and less or equal than 100, but got invalid value 1000
+}
+
+func TestBinder(t *testing.T) {
+	t.Run("bind", func(t *testing.T) {
+		values, _ := url.ParseQuery("keyword=go&id=1&id=2&tag=a&tag=b&offset=10")
+
+		q := &Query{}
+		require.NoError(t, Bind(values, q))
+		require.Equal(t, "go", q.Keyword)
+		require.Equal(t, []uint64{1, 2}, q.IDs)
+		require.Equal(t, []string{"a", "b"}, q.Tags)
+		require.Equal(t, 10, q.Offset)
+		require.Equal(t, 10, q.Size)
+	})
+
+	t.Run("custom tag", func(t *testing.T) {
+		type Q struct {
+			Keyword string `query:"q" validate:"@string[2,]"`
+		}
+
+		b := NewBinder("query")
+
+		err := b.Bind(url.Values{"q": {"a"}}, &Q{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "q string length")
+
+		// cached
+		q := &Q{}
+		require.NoError(t, b.Bind(url.Values{"q": {"ab"}}, q))
+		require.Equal(t, "ab", q.Keyword)
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		require.Error(t, Bind(url.Values{}, Query{}))
+	})
+}
@@ -0,0 +1,56 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/go-courier/reflectx/typesutil"
+)
+
+// DefaultMaxDepth is max depth of nested values of recursive struct types when not configured
+const DefaultMaxDepth = 32
+
+type contextKeyMaxDepth int
+
+// ContextWithMaxDepth sets max depth of nested values of recursive struct types for rules compiled with the ctx,
+// like `type Node struct { Children []Node }`.
+// Each nested value of the recursive struct type is one level, values deeper than max depth will be rejected.
+func ContextWithMaxDepth(ctx context.Context, maxDepth int) context.Context {
+	return context.WithValue(ctx, contextKeyMaxDepth(1), maxDepth)
+}
+
+func MaxDepthFromContext(ctx context.Context) int {
+	if maxDepth, ok := ctx.Value(contextKeyMaxDepth(1)).(int); ok && maxDepth > 0 {
+		return maxDepth
+	}
+	return DefaultMaxDepth
+}
+
+func isMaxDepthSet(ctx context.Context) bool {
+	_, ok := ctx.Value(contextKeyMaxDepth(1)).(int)
+	return ok
+}
+
+type contextKeyCompilingStructs int
+
+// contextWithCompilingStruct marks struct validator in compiling,
+// struct type compiled again in its fields will reference the same validator instead of compiling forever.
+func contextWithCompilingStruct(ctx context.Context, key string, structValidator *StructValidator) context.Context {
+	compiling := compilingStructsFromContext(ctx)
+
+	next := make(map[string]*StructValidator, len(compiling)+1)
+	for k, v := range compiling {
+		next[k] = v
+	}
+	next[key] = structValidator
+
+	return context.WithValue(ctx, contextKeyCompilingStructs(1), next)
+}
+
+func compilingStructsFromContext(ctx context.Context) map[string]*StructValidator {
+	compiling, _ := ctx.Value(contextKeyCompilingStructs(1)).(map[string]*StructValidator)
+	return compiling
+}
+
+func compilingStructKey(typ typesutil.Type, namedTagKey string) string {
+	return typesutil.FullTypeName(typ) + "<" + namedTagKey + ">"
+}
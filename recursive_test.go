@@ -0,0 +1,163 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type TreeNode struct {
+	Name     string     `json:"name" validate:"@string[1,]"`
+	Children []TreeNode `json:"children,omitempty"`
+}
+
+type ListNode struct {
+	Value int       `json:"value" validate:"@int[0,10]"`
+	Next  *ListNode `json:"next,omitempty"`
+}
+
+type Comment struct {
+	Text    string  `json:"text" validate:"@string[1,]"`
+	Replies []Reply `json:"replies,omitempty"`
+}
+
+type Reply struct {
+	Author  string   `json:"author" validate:"@string[1,]"`
+	Comment *Comment `json:"comment,omitempty"`
+}
+
+func newTree(depth int) TreeNode {
+	node := TreeNode{Name: "node"}
+	if depth > 1 {
+		node.Children = []TreeNode{newTree(depth - 1)}
+	}
+	return node
+}
+
+func TestRecursiveStruct(t *testing.T) {
+	ctx := ContextWithNamedTagKey(context.Background(), "json")
+
+	t.Run("tree", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(TreeNode{})))
+
+		require.NoError(t, v.Validate(newTree(5)))
+
+		tree := newTree(3)
+		tree.Children[0].Children[0].Name = ""
+
+		err := v.Validate(tree)
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"children[0].children[0].name"}, keyPaths)
+	})
+
+	t.Run("linked list", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(&ListNode{})))
+
+		require.NoError(t, v.Validate(&ListNode{Value: 1, Next: &ListNode{Value: 2}}))
+		require.Error(t, v.Validate(&ListNode{Value: 1, Next: &ListNode{Value: 11}}))
+	})
+
+	t.Run("mutual recursive", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(Comment{})))
+
+		require.NoError(t, v.Validate(Comment{
+			Text: "a",
+			Replies: []Reply{
+				{Author: "b", Comment: &Comment{Text: "c"}},
+			},
+		}))
+
+		require.Error(t, v.Validate(Comment{
+			Text: "a",
+			Replies: []Reply{
+				{Author: "b", Comment: &Comment{}},
+			},
+		}))
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(ContextWithMaxDepth(ctx, 3), nil, typesutil.FromRType(reflect.TypeOf(TreeNode{})))
+
+		require.NoError(t, v.Validate(newTree(3)))
+
+		err := v.Validate(newTree(4))
+		require.Error(t, err)
+
+		var fieldErr *errors.FieldError
+		err.(*errors.ErrorSet).Flatten().Each(func(e *errors.FieldError) {
+			fieldErr = e
+		})
+		require.Equal(t, "children[0].children[0].children[0]", fieldErr.Field.String())
+		require.Equal(t, &errors.MaxDepthExceededError{MaxDepth: 3}, fieldErr.Error)
+	})
+
+	t.Run("max depth by factory", func(t *testing.T) {
+		f := NewValidatorFactory()
+		f.Register(&StringValidator{}, &IntValidator{}, &StructValidator{}, &SliceValidator{})
+		f.SetMaxDepth(2)
+
+		v := f.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(TreeNode{})))
+		require.NoError(t, v.Validate(newTree(2)))
+		require.Error(t, v.Validate(newTree(3)))
+
+		v = f.MustCompile(ContextWithMaxDepth(ctx, 4), nil, typesutil.FromRType(reflect.TypeOf(TreeNode{})))
+		require.NoError(t, v.Validate(newTree(4)))
+	})
+
+	t.Run("apply defaults", func(t *testing.T) {
+		type Category struct {
+			Name string      `json:"name,omitempty" default:"unknown"`
+			Subs []*Category `json:"subs,omitempty"`
+		}
+
+		c := &Category{Subs: []*Category{{Name: "a"}, {}}}
+		require.NoError(t, ApplyDefaults(c))
+		require.Equal(t, "unknown", c.Name)
+		require.Equal(t, "a", c.Subs[0].Name)
+		require.Equal(t, "unknown", c.Subs[1].Name)
+	})
+
+	t.Run("apply defaults with max depth", func(t *testing.T) {
+		type Category struct {
+			Name string      `json:"name,omitempty" default:"unknown"`
+			Subs []*Category `json:"subs,omitempty"`
+		}
+
+		v := ValidatorMgrDefault.MustCompile(ContextWithMaxDepth(ctx, 2), nil, typesutil.FromRType(reflect.TypeOf(Category{})))
+
+		require.NoError(t, ApplyDefaultsBy(v, &Category{Subs: []*Category{{}}}))
+
+		err := ApplyDefaultsBy(v, &Category{Subs: []*Category{{Subs: []*Category{{}}}}})
+		require.Error(t, err)
+
+		var fieldErr *errors.FieldError
+		err.(*errors.ErrorSet).Flatten().Each(func(e *errors.FieldError) {
+			fieldErr = e
+		})
+		require.Equal(t, "subs[0].subs[0]", fieldErr.Field.String())
+		require.Equal(t, &errors.MaxDepthExceededError{MaxDepth: 2}, fieldErr.Error)
+	})
+
+	t.Run("apply defaults in cycle", func(t *testing.T) {
+		node := &ListNode{}
+		node.Next = node
+
+		err := ApplyDefaults(node)
+		require.Error(t, err)
+
+		var fieldErr *errors.FieldError
+		err.(*errors.ErrorSet).Flatten().Each(func(e *errors.FieldError) {
+			fieldErr = e
+		})
+		require.Equal(t, &errors.MaxDepthExceededError{MaxDepth: DefaultMaxDepth}, fieldErr.Error)
+	})
+}
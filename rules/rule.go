@@ -81,9 +81,18 @@ func (s *ruleScanner) rule() (*Rule, error) {
 	}
 	rule := NewRule(name)
 
+	// presence mark `!` should follow the name or the end of params, ranges, values and pattern
+	presenceAllowed := true
+
 LOOP:
 	for tok := s.Peek(); ; tok = s.Peek() {
 		switch tok {
+		case '!':
+			if !presenceAllowed {
+				break LOOP
+			}
+			s.Next()
+			rule.Presence = true
 		case '?', '=':
 			optional, defaultValue, err := s.optionalAndDefaultValue()
 			if err != nil {
@@ -91,6 +100,7 @@ LOOP:
 			}
 			rule.Optional = optional
 			rule.DefaultValue = defaultValue
+			presenceAllowed = false
 		case '<':
 			params, err := s.params()
 			if err != nil {
@@ -244,9 +254,16 @@ func (s *ruleScanner) values() ([]*RuleLit, error) {
 			s.Next()
 			valueCount++
 		default:
-			lit, err := s.scanLit()
-			if err != nil {
-				return nil, err
+			lit := ""
+			if tok == '/' || tok == ':' {
+				// part of values like CIDRs and IPv6 addresses
+				lit = string(s.Next())
+			} else {
+				l, err := s.scanLit()
+				if err != nil {
+					return nil, err
+				}
+				lit = l
 			}
 			if ruleLit, ok := ruleValues[valueCount]; !ok {
 				ruleValues[valueCount] = NewRuleLit([]byte(lit))
@@ -338,6 +355,15 @@ func (s *ruleScanner) optionalAndDefaultValue() (bool, []byte, error) {
 			return false, nil, err
 		}
 		b.WriteString(lit)
+
+		// rest of value, like `!` of `hi!`
+		for tok = s.Peek(); tok != scanner.EOF && tok != ' ' && !keychars[tok]; tok = s.Peek() {
+			lit, err := s.scanLit()
+			if err != nil {
+				return false, nil, err
+			}
+			b.WriteString(lit)
+		}
 	}
 
 	defaultValue := b.Bytes()
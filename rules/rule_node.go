@@ -33,6 +33,9 @@ type Rule struct {
 	Optional     bool
 	DefaultValue []byte
 
+	// only nil values are missing when marked with `!`, zero values will be validated
+	Presence bool
+
 	RuleNode
 }
 
@@ -125,6 +128,10 @@ func (r *Rule) Bytes() []byte {
 		buf.Write(Slash([]byte(r.Pattern.String())))
 	}
 
+	if r.Presence {
+		buf.WriteByte('!')
+	}
+
 	if r.Optional {
 		if r.DefaultValue != nil {
 			buf.WriteByte(' ')
@@ -32,10 +32,13 @@ func TestParseRule(t *testing.T) {
 		// with values
 		{`@string{A, B,    C}`, `@string{A,B,C}`},
 		{`@string{, B,    C}`, `@string{,B,C}`},
+		{`@string{A!,B}`, `@string{A!,B}`},
 		{`@uint{%2}`, `@uint{%2}`},
 
 		// with value matrix
 		{`@string{A, B,    C}{a,b}`, `@string{A,B,C}{a,b}`},
+		{`@ip<v4>{10.0.0.0/8, 192.168.0.0/16}`, `@ip<v4>{10.0.0.0/8,192.168.0.0/16}`},
+		{`@ip<v6>{fd00::/8}`, `@ip<v6>{fd00::/8}`},
 
 		// with not required mark or default value
 		{`@string?`, `@string?`},
@@ -46,6 +49,14 @@ func TestParseRule(t *testing.T) {
 		{`@string = 'defa\'ult\ value'`, `@string = 'defa\'ult\ value'`},
 		{`@string = 13123`, `@string = '13123'`},
 		{`@string = 1.1`, `@string = '1.1'`},
+		{`@string = hi!`, `@string = 'hi!'`},
+
+		// with presence mark
+		{`@int[0,10]!`, `@int[0,10]!`},
+		{`@int[0,10] !`, `@int[0,10]!`},
+		{`@string!?`, `@string!?`},
+		{`@string! = 's'`, `@string! = 's'`},
+		{`@slice<@int[0,]!>!`, `@slice<@int[0,]!>!`},
 
 		// with regexp
 		{`@string/\w+/`, `@string/\w+/`},
@@ -86,6 +97,7 @@ func TestParseRuleFailed(t *testing.T) {
 		`@name</>`,
 		`@/`,
 		`@name?=`,
+		`@name = 's'!`,
 	}
 
 	for _, c := range cases {
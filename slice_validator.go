@@ -24,12 +24,21 @@ Rules:
 
 Aliases
 	@array = @slice // and range must to be use length
+
+Parallelism
+	elements will be validated concurrently when compiled with ContextWithParallelism or ValidatorFactory.SetParallelism,
+	errors are still ordered by index.
 */
 type SliceValidator struct {
 	ElemValidator Validator
 
 	MinItems uint64
 	MaxItems *uint64
+
+	// count of workers to validate elements concurrently
+	Parallelism int
+
+	observed bool
 }
 
 func init() {
@@ -50,6 +59,18 @@ func (validator *SliceValidator) Validate(v interface{}) error {
 }
 
 func (validator *SliceValidator) ValidateReflectValue(rv reflect.Value) error {
+	return validator.validateReflectValue(rv, rootScopeIf(validator.observed))
+}
+
+func (validator *SliceValidator) validateInScope(v interface{}, s *scope) error {
+	rv, ok := v.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(v)
+	}
+	return validator.validateReflectValue(rv, s)
+}
+
+func (validator *SliceValidator) validateReflectValue(rv reflect.Value, s *scope) error {
 	lenOfValue := uint64(0)
 	if !rv.IsNil() {
 		lenOfValue = uint64(rv.Len())
@@ -71,8 +92,21 @@ func (validator *SliceValidator) ValidateReflectValue(rv reflect.Value) error {
 
 	if validator.ElemValidator != nil {
 		errs := errors.NewErrorSet("")
-		for i := 0; i < rv.Len(); i++ {
-			err := validator.ElemValidator.Validate(rv.Index(i))
+		n := rv.Len()
+
+		if validator.Parallelism > 1 && n > 1 {
+			results := make([]error, n)
+			parallelEach(n, validator.Parallelism, func(i int) {
+				results[i] = validateInScope(validator.ElemValidator, rv.Index(i), s.Child(i))
+			})
+			for i := range results {
+				errs.AddErr(results[i], i)
+			}
+			return errs.Err()
+		}
+
+		for i := 0; i < n; i++ {
+			err := validateInScope(validator.ElemValidator, rv.Index(i), s.Child(i))
 			if err != nil {
 				errs.AddErr(err, i)
 			}
@@ -83,7 +117,10 @@ func (validator *SliceValidator) ValidateReflectValue(rv reflect.Value) error {
 }
 
 func (SliceValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
-	sliceValidator := &SliceValidator{}
+	sliceValidator := &SliceValidator{
+		Parallelism: ParallelismFromContext(ctx),
+		observed:    ValidateObserverFromContext(ctx) != nil,
+	}
 
 	if rule.ExclusiveLeft || rule.ExclusiveRight {
 		return nil, errors.NewSyntaxError("range mark of %s should not be `(` or `)`", sliceValidator.Names()[0])
@@ -126,7 +163,7 @@ func (SliceValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
 
 	mgr := ValidatorMgrFromContext(ctx)
 
-	v, err := mgr.Compile(ctx, elemRule, rule.Type.Elem(), nil)
+	v, err := mgr.Compile(contextWithoutParallelism(ctx), elemRule, rule.Type.Elem(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("slice elem %s", err)
 	}
@@ -146,3 +183,10 @@ func (validator *SliceValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *SliceValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeList}
+	d.add(lengthConstraint(TargetSliceLength, validator.MinItems, validator.MaxItems))
+	d.Elem = Describe(validator.ElemValidator)
+	return d
+}
@@ -0,0 +1,281 @@
+package sqlcheck
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+)
+
+// Check is a CHECK constraint of column, expressions are in PostgreSQL dialect
+type Check struct {
+	Column string
+	Expr   string
+}
+
+func (c *Check) String() string {
+	return "CHECK (" + c.Expr + ")"
+}
+
+// Untranslatable notes rule which could not be translated to CHECK constraint
+type Untranslatable struct {
+	Column string
+	Rule   string
+	Reason string
+}
+
+func (u *Untranslatable) String() string {
+	return fmt.Sprintf("%s: %s %s", u.Column, u.Rule, u.Reason)
+}
+
+type Result struct {
+	Checks         []*Check
+	Untranslatable []*Untranslatable
+}
+
+// Generate compiles struct type and generates CHECK constraints for each column.
+// Column names are picked by columnTagKey, like `db:"f_name,size=255"`.
+func Generate(typ reflect.Type, columnTagKey string) (*Result, error) {
+	ctx := validator.ContextWithNamedTagKey(context.Background(), columnTagKey)
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return nil, err
+	}
+	return FromValidator(v)
+}
+
+// FromValidator generates CHECK constraints from compiled struct validator
+func FromValidator(v validator.Validator) (*Result, error) {
+	structValidator, ok := validator.UnwrapValidatorLoader(v).(*validator.StructValidator)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct validator", v)
+	}
+
+	result := &Result{}
+
+	for _, field := range structValidator.Fields() {
+		column := field.DisplayName
+		g := &generator{column: column, quoted: quoteIdent(column)}
+
+		fieldValidator := field.Validator
+		optional := false
+
+		if loader, ok := fieldValidator.(*validator.ValidatorLoader); ok {
+			fieldValidator = validator.UnwrapValidatorLoader(loader)
+			// zero value will be validated in presence mode or of driver.Valuer, NULL always passes CHECK
+			optional = loader.Optional && !loader.ZeroValidated()
+
+			if isValidatedAsText(loader) {
+				if fieldValidator != nil {
+					g.untranslatable(fieldValidator.String(), "value is validated as text of the Go value")
+				}
+				result.Untranslatable = append(result.Untranslatable, g.notes...)
+				continue
+			}
+		}
+
+		if noColumnConstraint(fieldValidator) {
+			continue
+		}
+
+		exprs := g.exprs(fieldValidator)
+
+		if len(exprs) > 0 {
+			expr := strings.Join(exprs, " AND ")
+			if optional {
+				// empty value of optional field will not be validated
+				if len(exprs) > 1 {
+					expr = "(" + expr + ")"
+				}
+				expr = g.quoted + " = " + g.zero + " OR " + expr
+			}
+			result.Checks = append(result.Checks, &Check{Column: column, Expr: expr})
+		}
+
+		result.Untranslatable = append(result.Untranslatable, g.notes...)
+	}
+
+	return result, nil
+}
+
+// isValidatedAsText returns true when value, or value wrapped by driver.Valuer, is validated as text
+func isValidatedAsText(loader *validator.ValidatorLoader) bool {
+	if loader.PreprocessStage == validator.PreprocessString {
+		return true
+	}
+	if elem, ok := loader.Validator.(*validator.ValidatorLoader); ok {
+		return isValidatedAsText(elem)
+	}
+	return false
+}
+
+// noColumnConstraint returns true when v is nil, struct validator, or collections of them without limits,
+// which are created for nested or recursive structs, fields of structs are not columns.
+func noColumnConstraint(v validator.Validator) bool {
+	switch x := validator.UnwrapValidatorLoader(v).(type) {
+	case nil, *validator.StructValidator:
+		return true
+	case *validator.SliceValidator:
+		return x.MinItems == 0 && x.MaxItems == nil && noColumnConstraint(x.ElemValidator)
+	case *validator.MapValidator:
+		return x.MinProperties == 0 && x.MaxProperties == nil && noColumnConstraint(x.KeyValidator) && noColumnConstraint(x.ElemValidator)
+	}
+	return false
+}
+
+type generator struct {
+	column string
+	quoted string
+	// literal of zero value
+	zero  string
+	notes []*Untranslatable
+}
+
+func (g *generator) untranslatable(rule string, reason string) {
+	g.notes = append(g.notes, &Untranslatable{Column: g.column, Rule: rule, Reason: reason})
+}
+
+func (g *generator) exprs(v validator.Validator) []string {
+	exprs := make([]string, 0)
+
+	switch x := v.(type) {
+	case *validator.StringValidator:
+		g.zero = "''"
+
+		if x.Enums != nil {
+			values := validator.EnumValues(x.Enums)
+			for i := range values {
+				values[i] = quoteString(values[i])
+			}
+			return append(exprs, g.quoted+" IN ("+strings.Join(values, ", ")+")")
+		}
+
+		if x.Pattern != nil {
+			if expr, ok := g.match(x.Pattern); ok {
+				exprs = append(exprs, expr)
+			} else {
+				g.untranslatable(x.String(), "regexp syntax is not supported by PostgreSQL")
+			}
+			return exprs
+		}
+
+		length := "octet_length(" + g.quoted + ")"
+		if x.LenMode == validator.STR_LEN_MODE__RUNE_COUNT {
+			length = "char_length(" + g.quoted + ")"
+		}
+
+		if x.MinLength > 0 {
+			exprs = append(exprs, length+" >= "+strconv.FormatUint(x.MinLength, 10))
+		}
+		if x.MaxLength != nil {
+			exprs = append(exprs, length+" <= "+strconv.FormatUint(*x.MaxLength, 10))
+		}
+	case *validator.StrfmtValidator:
+		g.zero = "''"
+
+		if re := x.Pattern(); re != nil {
+			if expr, ok := g.match(re); ok {
+				return append(exprs, expr)
+			}
+		}
+		g.untranslatable(x.String(), "format is validated by Go func")
+	case *validator.IntValidator:
+		g.zero = "0"
+
+		if x.Enums != nil {
+			return append(exprs, g.quoted+" IN ("+strings.Join(validator.EnumValues(x.Enums), ", ")+")")
+		}
+
+		// skip bounds of bit size, which are defaults
+		if x.Minimum != nil && *x.Minimum != validator.MinInt(x.BitSize) {
+			exprs = append(exprs, g.quoted+greater(x.ExclusiveMinimum)+strconv.FormatInt(*x.Minimum, 10))
+		}
+		if x.Maximum != nil && *x.Maximum != validator.MaxInt(x.BitSize) {
+			exprs = append(exprs, g.quoted+less(x.ExclusiveMaximum)+strconv.FormatInt(*x.Maximum, 10))
+		}
+		if x.MultipleOf != 0 {
+			exprs = append(exprs, g.quoted+" % "+strconv.FormatInt(x.MultipleOf, 10)+" = 0")
+		}
+	case *validator.UintValidator:
+		g.zero = "0"
+
+		if x.Enums != nil {
+			return append(exprs, g.quoted+" IN ("+strings.Join(validator.EnumValues(x.Enums), ", ")+")")
+		}
+
+		if x.Minimum != 0 || x.ExclusiveMinimum {
+			exprs = append(exprs, g.quoted+greater(x.ExclusiveMinimum)+strconv.FormatUint(x.Minimum, 10))
+		}
+		if x.Maximum != validator.MaxUint(x.BitSize) {
+			exprs = append(exprs, g.quoted+less(x.ExclusiveMaximum)+strconv.FormatUint(x.Maximum, 10))
+		}
+		if x.MultipleOf != 0 {
+			exprs = append(exprs, g.quoted+" % "+strconv.FormatUint(x.MultipleOf, 10)+" = 0")
+		}
+	case *validator.FloatValidator:
+		g.zero = "0"
+
+		if x.Enums != nil {
+			return append(exprs, g.quoted+" IN ("+strings.Join(validator.EnumValues(x.Enums), ", ")+")")
+		}
+
+		if x.Minimum != nil {
+			exprs = append(exprs, g.quoted+greater(x.ExclusiveMinimum)+formatFloat(*x.Minimum))
+		}
+		if x.Maximum != nil {
+			exprs = append(exprs, g.quoted+less(x.ExclusiveMaximum)+formatFloat(*x.Maximum))
+		}
+		if x.MultipleOf != 0 {
+			exprs = append(exprs, "mod("+g.quoted+"::numeric, "+formatFloat(x.MultipleOf)+") = 0")
+		}
+		if x.DecimalDigits != nil {
+			g.untranslatable(x.String(), fmt.Sprintf("digits should be declared by column type numeric(%d,%d)", x.MaxDigits, *x.DecimalDigits))
+		}
+	default:
+		g.untranslatable(v.String(), "is not a rule of scalar value")
+	}
+
+	return exprs
+}
+
+// named groups, flags not at the beginning and \z are not supported by ARE of PostgreSQL
+var reUnsupportedRegexpSyntax = regexp.MustCompile(`\(\?P<|.\(\?[imsU-]+[:)]|\\z`)
+
+func (g *generator) match(re *regexp.Regexp) (string, bool) {
+	s := re.String()
+	if reUnsupportedRegexpSyntax.MatchString(s) {
+		return "", false
+	}
+	return g.quoted + " ~ " + quoteString(s), true
+}
+
+func greater(exclusive bool) string {
+	if exclusive {
+		return " > "
+	}
+	return " >= "
+}
+
+func less(exclusive bool) string {
+	if exclusive {
+		return " < "
+	}
+	return " <= "
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+func quoteString(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
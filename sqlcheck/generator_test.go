@@ -0,0 +1,122 @@
+package sqlcheck
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type User struct {
+	Name      string    `db:"f_name" validate:"@char[1,32]"`
+	Code      string    `db:"f_code" validate:"@string[6]"`
+	Slug      string    `db:"f_slug,omitempty" validate:"@string/^[a-z0-9-]+$/"`
+	Role      string    `db:"f_role" validate:"@string{ADMIN,MEMBER}"`
+	Age       int       `db:"f_age,omitempty" validate:"@int[18,120)"`
+	Step      uint      `db:"f_step" validate:"@uint{%5}"`
+	Score     float64   `db:"f_score" validate:"@float<5,2>[0,100]"`
+	Tags      []string  `db:"f_tags" validate:"@slice<@string[1,]>[,3]"`
+	CreatedAt time.Time `db:"f_created_at" validate:"@string[1,]"`
+}
+
+func ExampleGenerate() {
+	result, err := Generate(reflect.TypeOf(User{}), "db")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, check := range result.Checks {
+		fmt.Println(check)
+	}
+	for _, untranslatable := range result.Untranslatable {
+		fmt.Println("--", untranslatable)
+	}
+	// Output:
+	// CHECK (char_length("f_name") >= 1 AND char_length("f_name") <= 32)
+	// CHECK (octet_length("f_code") >= 6 AND octet_length("f_code") <= 6)
+	// CHECK ("f_slug" = '' OR "f_slug" ~ '^[a-z0-9-]+$')
+	// CHECK ("f_role" IN ('ADMIN', 'MEMBER'))
+	// CHECK ("f_age" = 0 OR ("f_age" >= 18 AND "f_age" < 120))
+	// CHECK ("f_step" % 5 = 0)
+	// CHECK ("f_score" >= 0 AND "f_score" <= 100)
+	// -- f_score: @float<5,2>[0.00,100.00] digits should be declared by column type numeric(5,2)
+	// -- f_tags: @slice<@string<length>[1,]>[0,3] is not a rule of scalar value
+	// -- f_created_at: @string<length>[1,] value is validated as text of the Go value
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("quote", func(t *testing.T) {
+		result, err := Generate(reflect.TypeOf(struct {
+			Value string `db:"value" validate:"@string/^O'K$/"`
+			Named string `db:"na\"me" validate:"@string/^(?P<name>\\w+)$/"`
+		}{}), "db")
+		require.NoError(t, err)
+
+		require.Len(t, result.Checks, 1)
+		require.Equal(t, `"value" ~ '^O''K$'`, result.Checks[0].Expr)
+
+		require.Len(t, result.Untranslatable, 1)
+		require.Equal(t, `na"me`, result.Untranslatable[0].Column)
+	})
+
+	t.Run("numeric enums and multiple of float", func(t *testing.T) {
+		result, err := Generate(reflect.TypeOf(struct {
+			Size  int     `db:"size" validate:"@int{10,9}"`
+			Ratio float64 `db:"ratio" validate:"@float<5,1>{%0.5}"`
+		}{}), "db")
+		require.NoError(t, err)
+
+		require.Equal(t, `"size" IN (9, 10)`, result.Checks[0].Expr)
+		require.Equal(t, `mod("ratio"::numeric, 0.5) = 0`, result.Checks[1].Expr)
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		result, err := Generate(reflect.TypeOf(struct {
+			Count int `db:"f_count,omitempty" validate:"@int[1,10]!"`
+			Size  int `db:"f_size,omitempty" validate:"@int[1,10]"`
+		}{}), "db")
+		require.NoError(t, err)
+
+		require.Equal(t, `"f_count" >= 1 AND "f_count" <= 10`, result.Checks[0].Expr)
+		require.Equal(t, `"f_size" = 0 OR ("f_size" >= 1 AND "f_size" <= 10)`, result.Checks[1].Expr)
+	})
+
+	t.Run("sql null types", func(t *testing.T) {
+		result, err := Generate(reflect.TypeOf(struct {
+			Name  sql.NullString `db:"f_name" validate:"@string[1,10]"`
+			Nick  sql.NullString `db:"f_nick,omitempty" validate:"@string[1,10]"`
+			Score sql.NullInt64  `db:"f_score,omitempty" validate:"@int[0,100]"`
+		}{}), "db")
+		require.NoError(t, err)
+
+		require.Len(t, result.Checks, 3)
+		require.Equal(t, `octet_length("f_name") >= 1 AND octet_length("f_name") <= 10`, result.Checks[0].Expr)
+		require.Equal(t, `octet_length("f_nick") >= 1 AND octet_length("f_nick") <= 10`, result.Checks[1].Expr)
+		require.Equal(t, `"f_score" >= 0 AND "f_score" <= 100`, result.Checks[2].Expr)
+		require.Empty(t, result.Untranslatable)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		type Node struct {
+			Name     string           `db:"f_name" validate:"@string[1,]"`
+			Parent   *Node            `db:"f_parent,omitempty"`
+			Children []*Node          `db:"f_children,omitempty"`
+			Labeled  map[string]*Node `db:"f_labeled,omitempty"`
+		}
+
+		result, err := Generate(reflect.TypeOf(Node{}), "db")
+		require.NoError(t, err)
+
+		require.Len(t, result.Checks, 1)
+		require.Equal(t, `octet_length("f_name") >= 1`, result.Checks[0].Expr)
+		require.Empty(t, result.Untranslatable)
+	})
+
+	t.Run("not struct", func(t *testing.T) {
+		_, err := Generate(reflect.TypeOf(""), "db")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,182 @@
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/errors"
+)
+
+// NewDecoder creates Decoder to decode elements of typ from r and validate each of them by v.
+// r could be a JSON array or NDJSON (and any other concatenated JSON values).
+func NewDecoder(r io.Reader, typ reflect.Type, v validator.Validator) *Decoder {
+	lines := newLineReader(r)
+
+	return &Decoder{
+		typ:       typ,
+		validator: v,
+		lines:     lines,
+		reader:    bufio.NewReader(lines),
+	}
+}
+
+// Decoder decodes elements one by one, so huge input never need to be materialized.
+type Decoder struct {
+	typ       reflect.Type
+	validator validator.Validator
+
+	lines  *lineReader
+	reader *bufio.Reader
+	dec    *json.Decoder
+
+	// bytes skipped before the json decoder started
+	skipped int64
+	isArray bool
+	index   int
+}
+
+// Result of each element
+type Result struct {
+	// index of element, start from 0
+	Index int
+	// line number where element starts, start from 1
+	Line int
+	// decoded value of element
+	Value interface{}
+	// nil or *ElementError when decode or validate failed
+	Err error
+}
+
+// Next returns Result of next element, or io.EOF when no more elements.
+// Errors of element are put in Result.Err, any other error means the input is broken.
+func (d *Decoder) Next() (*Result, error) {
+	if d.dec == nil {
+		if err := d.start(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !d.dec.More() {
+		if d.isArray {
+			if _, err := d.dec.Token(); err != nil {
+				return nil, err
+			}
+		}
+		return nil, io.EOF
+	}
+
+	raw := json.RawMessage{}
+	if err := d.dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Index: d.index,
+		Line:  d.lines.LineAt(d.skipped + d.dec.InputOffset() - int64(len(raw))),
+	}
+	d.index++
+
+	rv := reflect.New(d.typ)
+
+	if err := json.Unmarshal(raw, rv.Interface()); err != nil {
+		result.Err = &ElementError{Index: result.Index, Line: result.Line, Err: err}
+		return result, nil
+	}
+
+	if d.validator != nil {
+		if err := d.validator.Validate(rv.Elem()); err != nil {
+			result.Err = &ElementError{Index: result.Index, Line: result.Line, Err: err}
+		}
+	}
+
+	result.Value = rv.Elem().Interface()
+
+	return result, nil
+}
+
+// Each calls fn for each element until fn returns error
+func (d *Decoder) Each(fn func(result *Result) error) error {
+	for {
+		result, err := d.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := fn(result); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *Decoder) start() error {
+	for {
+		b, err := d.reader.Peek(1)
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if len(b) == 1 && isSpace(b[0]) {
+			_, _ = d.reader.ReadByte()
+			d.skipped++
+			continue
+		}
+
+		d.isArray = len(b) == 1 && b[0] == '['
+		break
+	}
+
+	d.dec = json.NewDecoder(d.reader)
+
+	if d.isArray {
+		if _, err := d.dec.Token(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+type ElementError struct {
+	Index int
+	Line  int
+	Err   error
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ElementError) Error() string {
+	prefix := fmt.Sprintf("line %d:", e.Line)
+
+	errSet, ok := e.Err.(*errors.ErrorSet)
+	if !ok {
+		return prefix + " " + e.Err.Error()
+	}
+
+	buf := bytes.NewBuffer(nil)
+	errSet.Flatten().Each(func(fieldErr *errors.FieldError) {
+		if buf.Len() > 0 {
+			buf.WriteRune('\n')
+		}
+		buf.WriteString(prefix)
+		if keyPath := fieldErr.Field.String(); keyPath != "" {
+			buf.WriteRune(' ')
+			buf.WriteString(keyPath)
+		}
+		buf.WriteRune(' ')
+		buf.WriteString(fieldErr.Error.Error())
+	})
+	return buf.String()
+}
@@ -0,0 +1,102 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/stretchr/testify/require"
+)
+
+type User struct {
+	Name  string `json:"name" validate:"@string[1,]"`
+	Email string `json:"email" validate:"@string/@/"`
+	Age   int    `json:"age,omitempty" validate:"@int[0,150] = 18"`
+}
+
+func compileUser() validator.Validator {
+	return validator.ValidatorMgrDefault.MustCompile(validator.ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(reflect.TypeOf(User{})))
+}
+
+func ExampleDecoder() {
+	ndjson := `{"name":"a","email":"a@x.io"}
+{"name":"b","email":"b"}
+
+{"name":"","email":"c@x.io","age":200}
+`
+
+	d := NewDecoder(strings.NewReader(ndjson), reflect.TypeOf(User{}), compileUser())
+
+	_ = d.Each(func(result *Result) error {
+		if result.Err != nil {
+			fmt.Println(result.Err)
+			return nil
+		}
+		fmt.Println(result.Index, result.Line, result.Value.(User).Age)
+		return nil
+	})
+	// Output:
+	// 0 1 18
+	// line 2: email string length @ not match b
+	// line 4: name missing required field
+	// line 4: age int value should be larger or equal than 0 and less or equal than 150, but got invalid value 200
+}
+
+func TestDecoder(t *testing.T) {
+	t.Run("json array", func(t *testing.T) {
+		data := `
+  [
+	{"name":"a","email":"a@x.io"},
+	{
+		"name":"",
+		"email":"b@x.io"
+	},
+	{"name":"c","email":"c@x.io","age":"1"}
+]`
+
+		d := NewDecoder(strings.NewReader(data), reflect.TypeOf(User{}), compileUser())
+
+		results := make([]*Result, 0)
+		require.NoError(t, d.Each(func(result *Result) error {
+			results = append(results, result)
+			return nil
+		}))
+
+		require.Len(t, results, 3)
+
+		require.NoError(t, results[0].Err)
+		require.Equal(t, 3, results[0].Line)
+
+		require.Equal(t, 1, results[1].Index)
+		require.Equal(t, 4, results[1].Line)
+		require.Equal(t, "line 4: name missing required field", results[1].Err.Error())
+
+		// type mismatch
+		require.Equal(t, 8, results[2].Line)
+		require.Error(t, results[2].Err)
+		require.Equal(t, 2, results[2].Err.(*ElementError).Index)
+	})
+
+	t.Run("empty array", func(t *testing.T) {
+		d := NewDecoder(strings.NewReader(`[]`), reflect.TypeOf(User{}), compileUser())
+		_, err := d.Next()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("broken input", func(t *testing.T) {
+		d := NewDecoder(strings.NewReader(`{"name":"a","email":"a@x.io"} {"name":`), reflect.TypeOf(User{}), compileUser())
+
+		result, err := d.Next()
+		require.NoError(t, err)
+		require.NoError(t, result.Err)
+
+		_, err = d.Next()
+		require.Error(t, err)
+		require.NotEqual(t, io.EOF, err)
+	})
+}
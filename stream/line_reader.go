@@ -0,0 +1,43 @@
+package stream
+
+import (
+	"io"
+)
+
+func newLineReader(r io.Reader) *lineReader {
+	return &lineReader{
+		r:    r,
+		line: 1,
+	}
+}
+
+// lineReader records offsets of line breaks to resolve line number of offset,
+// offsets before the latest resolved one will be dropped, so memory is bounded by the read-ahead buffer.
+type lineReader struct {
+	r        io.Reader
+	offset   int64
+	newlines []int64
+	line     int
+}
+
+func (l *lineReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	for i, b := range p[:n] {
+		if b == '\n' {
+			l.newlines = append(l.newlines, l.offset+int64(i))
+		}
+	}
+	l.offset += int64(n)
+	return n, err
+}
+
+// LineAt returns line number of offset, offset should never go back
+func (l *lineReader) LineAt(offset int64) int {
+	i := 0
+	for i < len(l.newlines) && l.newlines[i] < offset {
+		i++
+	}
+	l.line += i
+	l.newlines = l.newlines[i:]
+	return l.line
+}
@@ -20,7 +20,9 @@ func NewRegexpStrfmtValidator(regexpStr string, name string, aliases ...string)
 		}
 		return nil
 	}
-	return NewStrfmtValidator(validate, name, aliases...)
+	validator := NewStrfmtValidator(validate, name, aliases...)
+	validator.pattern = re
+	return validator
 }
 
 func NewStrfmtValidator(validate func(v interface{}) error, name string, aliases ...string) *StrfmtValidator {
@@ -33,12 +35,26 @@ func NewStrfmtValidator(validate func(v interface{}) error, name string, aliases
 type StrfmtValidator struct {
 	names    []string
 	validate func(v interface{}) error
+	pattern  *regexp.Regexp
+}
+
+// Pattern returns the regexp of validator created by NewRegexpStrfmtValidator, otherwise nil
+func (validator *StrfmtValidator) Pattern() *regexp.Regexp {
+	return validator.pattern
 }
 
 func (validator *StrfmtValidator) String() string {
 	return "@" + validator.names[0]
 }
 
+func (validator *StrfmtValidator) Describe() *Description {
+	return (&Description{Type: DescriptionTypeString}).add(&Constraint{
+		Code:   errors.CodeNotMatch,
+		Target: validator.names[0],
+		Params: map[string]interface{}{"format": validator.names[0]},
+	})
+}
+
 func (validator *StrfmtValidator) Names() []string {
 	return validator.names
 }
@@ -55,12 +71,13 @@ func (validator *StrfmtValidator) TypeCheck(rule *Rule) error {
 }
 
 func (validator *StrfmtValidator) Validate(v interface{}) error {
-	if rv, ok := v.(reflect.Value); ok && rv.CanInterface() {
-		v = rv.Interface()
-	}
-	s, ok := v.(string)
+	rv, ok := v.(reflect.Value)
 	if !ok {
-		return errors.NewUnsupportedTypeError(reflect.TypeOf(v).String(), validator.String())
+		rv = reflect.ValueOf(v)
+	}
+	// named string types, like `type Email string`
+	if rv.Kind() != reflect.String {
+		return errors.NewUnsupportedTypeError(rv.Type().String(), validator.String())
 	}
-	return validator.validate(s)
+	return validator.validate(rv.String())
 }
@@ -119,8 +119,11 @@ func (validator *StringValidator) Validate(v interface{}) error {
 		return errors.NewUnsupportedTypeError(rv.Type().String(), validator.String())
 	}
 
-	s := rv.Convert(typString).String()
+	return validator.validateString(rv.Convert(typString).String())
+}
 
+// validateString validates string value without reflect
+func (validator *StringValidator) validateString(s string) error {
 	if validator.Enums != nil {
 		if _, ok := validator.Enums[s]; !ok {
 			values := make([]interface{}, 0)
@@ -130,7 +133,7 @@ func (validator *StringValidator) Validate(v interface{}) error {
 
 			return &errors.NotInEnumError{
 				Target:  "string value",
-				Current: v,
+				Current: s,
 				Enums:   values,
 			}
 		}
@@ -142,7 +145,7 @@ func (validator *StringValidator) Validate(v interface{}) error {
 			return &errors.NotMatchError{
 				Target:  TargetStringLength,
 				Pattern: validator.Pattern,
-				Current: v,
+				Current: s,
 			}
 		}
 		return nil
@@ -245,3 +248,22 @@ func (validator *StringValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *StringValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeString}
+
+	if validator.Enums != nil {
+		return d.add(enumConstraint("string value", validator.Enums))
+	}
+
+	if validator.Pattern != nil {
+		return d.add(patternConstraint(TargetStringLength, validator.Pattern))
+	}
+
+	if c := lengthConstraint(TargetStringLength, validator.MinLength, validator.MaxLength); c != nil {
+		c.Params["lenMode"] = validator.LenMode.String()
+		d.add(c)
+	}
+
+	return d
+}
@@ -36,12 +36,36 @@ func NamedKeyFromContext(ctx context.Context) string {
 type StructValidator struct {
 	namedTagKey     string
 	fieldValidators map[string]Validator
+	fields          []*StructField
+	observed        bool
+	// referenced by its fields, directly or indirectly
+	recursive bool
+	maxDepth  int
+}
+
+// StructField is a compiled field of struct
+type StructField struct {
+	// name of Go struct field
+	Name string
+	// name by named tag key
+	DisplayName string
+	Type        typesutil.Type
+	Validator   Validator
 }
 
 func init() {
 	ValidatorMgrDefault.Register(&StructValidator{})
 }
 
+func (validator *StructValidator) NamedTagKey() string {
+	return validator.namedTagKey
+}
+
+// Fields returns compiled fields in declaration order, fields of embedded struct will be flattened
+func (validator *StructValidator) Fields() []*StructField {
+	return validator.fields
+}
+
 func (StructValidator) Names() []string {
 	return []string{"struct"}
 }
@@ -56,12 +80,31 @@ func (validator *StructValidator) Validate(v interface{}) error {
 }
 
 func (validator *StructValidator) ValidateReflectValue(rv reflect.Value) error {
+	return validator.validateReflectValue(rv, rootScopeIf(validator.observed || validator.recursive))
+}
+
+func (validator *StructValidator) validateInScope(v interface{}, s *scope) error {
+	rv, ok := v.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(v)
+	}
+	return validator.validateReflectValue(rv, s)
+}
+
+func (validator *StructValidator) validateReflectValue(rv reflect.Value, s *scope) error {
+	if validator.recursive {
+		if s.Depth() >= validator.maxDepth {
+			return &errors.MaxDepthExceededError{MaxDepth: validator.maxDepth}
+		}
+		s = s.Deeper()
+	}
+
 	errSet := errors.NewErrorSet("")
-	validator.validate(rv, errSet)
+	validator.validate(rv, errSet, s)
 	return errSet.Err()
 }
 
-func (validator *StructValidator) validate(rv reflect.Value, errSet *errors.ErrorSet) {
+func (validator *StructValidator) validate(rv reflect.Value, errSet *errors.ErrorSet, s *scope) {
 	typ := rv.Type()
 	for i := 0; i < rv.NumField(); i++ {
 		field := typ.Field(i)
@@ -79,12 +122,12 @@ func (validator *StructValidator) validate(rv reflect.Value, errSet *errors.Erro
 			if fieldValue.Kind() == reflect.Ptr && fieldValue.IsNil() {
 				fieldValue = reflectx.New(fieldType)
 			}
-			validator.validate(fieldValue, errSet)
+			validator.validate(fieldValue, errSet, s)
 			continue
 		}
 
 		if fieldValidator, ok := validator.fieldValidators[field.Name]; ok {
-			err := fieldValidator.Validate(fieldValue)
+			err := validateInScope(fieldValidator, fieldValue, s.Child(fieldName))
 			errSet.AddErr(err, fieldName)
 		}
 	}
@@ -94,6 +137,7 @@ const (
 	TagValidate = "validate"
 	TagDefault  = "default"
 	TagErrMsg   = "errMsg"
+	TagSeverity = "severity"
 )
 
 func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
@@ -111,10 +155,21 @@ func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validato
 		namedTagKey = validator.namedTagKey
 	}
 
+	key := compilingStructKey(rule.Type, namedTagKey)
+
+	if compiling, ok := compilingStructsFromContext(ctx)[key]; ok {
+		// fields will be resolved when the compiling finished
+		compiling.recursive = true
+		return compiling, nil
+	}
+
 	structValidator := NewStructValidator(namedTagKey)
+	structValidator.observed = ValidateObserverFromContext(ctx) != nil
+	structValidator.maxDepth = MaxDepthFromContext(ctx)
 	errSet := errors.NewErrorSet("")
 
 	ctx = ContextWithNamedTagKey(ctx, structValidator.namedTagKey)
+	ctx = contextWithCompilingStruct(ctx, key, structValidator)
 
 	mgr := ValidatorMgrFromContext(ctx)
 
@@ -122,11 +177,17 @@ func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validato
 		tagValidateValue := field.Tag().Get(TagValidate)
 
 		if tagValidateValue == "" && typesutil.Deref(field.Type()).Kind() == reflect.Struct {
-			if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(field.Type()); !ok {
+			if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(field.Type()); !ok && !isValuerType(field.Type()) {
 				tagValidateValue = structValidator.String()
 			}
 		}
 
+		severity, err := errors.ParseSeverity(field.Tag().Get(TagSeverity))
+		if err != nil {
+			errSet.AddErr(err, field.Name())
+			return true
+		}
+
 		fieldValidator, err := mgr.Compile(ContextWithNamedTagKey(ctx, namedTagKey), []byte(tagValidateValue), field.Type(), func(rule RuleModifier) {
 			if omitempty {
 				rule.SetOptional(omitempty)
@@ -137,6 +198,11 @@ func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validato
 			if errMsg, ok := field.Tag().Lookup(TagErrMsg); ok {
 				rule.SetErrMsg([]byte(errMsg))
 			}
+			if severity != errors.SeverityError {
+				if severityModifier, ok := rule.(SeverityModifier); ok {
+					severityModifier.SetSeverity(severity)
+				}
+			}
 		})
 
 		if err != nil {
@@ -146,6 +212,12 @@ func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validato
 
 		if fieldValidator != nil {
 			structValidator.fieldValidators[field.Name()] = fieldValidator
+			structValidator.fields = append(structValidator.fields, &StructField{
+				Name:        field.Name(),
+				DisplayName: fieldDisplayName,
+				Type:        field.Type(),
+				Validator:   fieldValidator,
+			})
 		}
 		return true
 	})
@@ -156,3 +228,7 @@ func (validator *StructValidator) New(ctx context.Context, rule *Rule) (Validato
 func (validator *StructValidator) String() string {
 	return "@" + validator.Names()[0] + "<" + validator.namedTagKey + ">"
 }
+
+func (validator *StructValidator) Describe() *Description {
+	return &Description{Type: DescriptionTypeObject}
+}
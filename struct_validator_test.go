@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/go-courier/reflectx/typesutil"
@@ -53,6 +54,39 @@ func TestStructValidator_New(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestStructValidator_Fields(t *testing.T) {
+	type SubPtrStruct struct {
+		PtrInt *int `validate:"@int[1,]"`
+	}
+
+	type SubStruct struct {
+		Int int `validate:"@int[1,]"`
+	}
+
+	type SomeStruct struct {
+		String    string   `json:"string" validate:"@string[1,]"`
+		PtrString *string  `json:",omitempty" validate:"@string[3,]"`
+		Ignored   string   `json:"-"`
+		Slice     []string `validate:"@slice<@string[1,]>"`
+		SubStruct
+		*SubPtrStruct
+	}
+
+	v, err := NewStructValidator("json").New(ContextWithValidatorMgr(context.Background(), ValidatorMgrDefault), &Rule{
+		Type: typesutil.FromRType(reflect.TypeOf(&SomeStruct{}).Elem()),
+	})
+	require.NoError(t, err)
+
+	names := make([]string, 0)
+	displayNames := make([]string, 0)
+	for _, field := range v.(*StructValidator).Fields() {
+		names = append(names, field.Name)
+		displayNames = append(displayNames, field.DisplayName)
+	}
+	require.Equal(t, []string{"String", "PtrString", "Slice", "Int", "PtrInt"}, names)
+	require.Equal(t, []string{"string", "PtrString", "Slice", "Int", "PtrInt"}, displayNames)
+}
+
 func TestStructValidator_NewFailed(t *testing.T) {
 	type Named string
 
@@ -167,7 +201,7 @@ func ExampleNewStructValidator() {
 	// Output:
 	// JustRequired "missing required field"
 	// Map.1 "missing required field"
-	// Map.1/key "string length should be larger than 2, but got invalid value 1"
+	// Map.1/key "string length should be larger or equal than 2, but got invalid value 1"
 	// Map.11 "missing required field"
 	// Map.12 "missing required field"
 	// MapStruct.222.float "missing required field"
@@ -192,3 +226,30 @@ func ExampleNewStructValidator() {
 	// int "missing required field"
 	// uint "missing required field"
 }
+
+func ExampleStructValidator_severity() {
+	type SomeStruct struct {
+		Title  string `validate:"@string[1,80]" severity:"warn"`
+		Status string `validate:"@string{ACTIVE,DEPRECATED}"`
+		Level  string `validate:"@string{LOW,HIGH}" severity:"warn" errMsg:"level should be LOW or HIGH"`
+	}
+
+	structValidator := ValidatorMgrDefault.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(SomeStruct{})))
+
+	errForValidate := structValidator.Validate(SomeStruct{
+		Title:  strings.Repeat("x", 81),
+		Status: "ACTIVE",
+		Level:  "MIDDLE",
+	})
+
+	err, warnings := errors.Split(errForValidate)
+
+	fmt.Println(err)
+	warnings.Each(func(fieldErr *errors.FieldError) {
+		fmt.Println(fieldErr.Field, fieldErr.Severity, fieldErr.Error)
+	})
+	// Output:
+	// <nil>
+	// Title warning string length should be less or equal than 80, but got invalid value 81
+	// Level warning level should be LOW or HIGH
+}
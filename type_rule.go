@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/go-courier/validator/rules"
+)
+
+/*
+ValidateRuler could be implemented by named types to declare rule of their own,
+which will be used when compiling values of the type (or pointer of the type) without rule.
+
+	type Email string
+
+	func (Email) ValidateRule() string {
+		return "@email"
+	}
+
+Rules of fields will override the type rule when they have the same validator name,
+or both of them should be passed.
+
+	type User struct {
+		Email Email // @email
+		WorkEmail Email `validate:"@email[,64]"` // overridden
+		ShortEmail Email `validate:"@string[,32]"` // @email and @string[,32]
+	}
+*/
+type ValidateRuler interface {
+	ValidateRule() string
+}
+
+var rtypeValidateRuler = reflect.TypeOf((*ValidateRuler)(nil)).Elem()
+
+// RegisterTypeRule registers rule for values of typ (or pointer of typ),
+// it will take priority of the rule declared by ValidateRuler.
+func (f *ValidatorFactory) RegisterTypeRule(typ reflect.Type, rule string) error {
+	if _, err := rules.ParseRuleString(rule); err != nil {
+		return fmt.Errorf("invalid rule of %s: %s", typ, err)
+	}
+	f.configure(func() {
+		f.typeRules[typ] = []byte(rule)
+	})
+	return nil
+}
+
+func (f *ValidatorFactory) MustRegisterTypeRule(typ reflect.Type, rule string) {
+	if err := f.RegisterTypeRule(typ, rule); err != nil {
+		panic(err)
+	}
+}
+
+// typeRule returns rule of typ registered or declared by ValidateRuler, f.mu should be held
+func (f *ValidatorFactory) typeRule(typ typesutil.Type) ([]byte, bool) {
+	rtype, ok := typ.(*typesutil.RType)
+	if !ok {
+		return nil, false
+	}
+
+	for t := rtype.Type; ; t = t.Elem() {
+		if rule, ok := f.typeRules[t]; ok {
+			return rule, true
+		}
+
+		if t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface {
+			if t.Implements(rtypeValidateRuler) {
+				return []byte(reflect.Zero(t).Interface().(ValidateRuler).ValidateRule()), true
+			}
+			if reflect.PtrTo(t).Implements(rtypeValidateRuler) {
+				return []byte(reflect.New(t).Interface().(ValidateRuler).ValidateRule()), true
+			}
+		}
+
+		if t.Kind() != reflect.Ptr {
+			return nil, false
+		}
+	}
+}
+
+/*
+AllOfValidator composes validators, value should pass all of them.
+Created when rule of field and rule of type are for different validators.
+Errors of all failed validators will be reported.
+*/
+type AllOfValidator struct {
+	Validators []Validator
+}
+
+func (validator *AllOfValidator) Validate(v interface{}) error {
+	return validator.validateInScope(v, nil)
+}
+
+// validateInScope validates value by all validators, errors of them will be collected
+func (validator *AllOfValidator) validateInScope(v interface{}, s *scope) error {
+	errs := make([]error, 0)
+
+	for i := range validator.Validators {
+		if err := validateInScope(validator.Validators[i], v, s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+
+	errSet := errors.NewErrorSet("")
+	for i := range errs {
+		errSet.AddErr(errs[i])
+	}
+	return errSet
+}
+
+func (validator *AllOfValidator) String() string {
+	b := strings.Builder{}
+	for i := range validator.Validators {
+		if i > 0 {
+			b.WriteString(" & ")
+		}
+		b.WriteString(validator.Validators[i].String())
+	}
+	return b.String()
+}
+
+// Describe merges constraints of validators for the same type of value
+func (validator *AllOfValidator) Describe() *Description {
+	var d *Description
+
+	for i := range validator.Validators {
+		sub := Describe(validator.Validators[i])
+		if sub == nil {
+			continue
+		}
+		if d == nil {
+			copied := *sub
+			copied.Constraints = append([]*Constraint{}, sub.Constraints...)
+			d = &copied
+			continue
+		}
+		if sub.Type == d.Type {
+			d.Constraints = append(d.Constraints, sub.Constraints...)
+		}
+	}
+
+	return d
+}
+
+func (validator *AllOfValidator) applyDefaults(rv reflect.Value, errSet *errors.ErrorSet, keyPath errors.KeyPath, depth int) {
+	for i := range validator.Validators {
+		applyDefaults(validator.Validators[i], rv, errSet, keyPath, depth)
+	}
+}
@@ -0,0 +1,123 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type Code string
+
+func (Code) ValidateRule() string {
+	return "@upper"
+}
+
+type Level int
+
+func (*Level) ValidateRule() string {
+	return "@int[1,5]"
+}
+
+func TestTypeRule(t *testing.T) {
+	type Data struct {
+		Code       Code  `json:"code"`
+		PtrCode    *Code `json:"ptrCode,omitempty"`
+		ShortCode  Code  `json:"shortCode" validate:"@string[1,3]"`
+		Level      Level `json:"level"`
+		HighLevel  Level `json:"highLevel" validate:"@int[4,5]"`
+		OptionalLv Level `json:"optionalLv,omitempty"`
+	}
+
+	f := NewValidatorFactory()
+	f.Register(&StructValidator{}, &StringValidator{}, &IntValidator{}, NewRegexpStrfmtValidator(`^[A-Z]+$`, "upper"))
+
+	v := f.MustCompile(ContextWithNamedTagKey(context.Background(), "json"), nil, typesutil.FromRType(reflect.TypeOf(Data{})))
+
+	fields := map[string]string{}
+	for _, f := range v.(*ValidatorLoader).Validator.(*StructValidator).Fields() {
+		fields[f.DisplayName] = f.Validator.String()
+	}
+
+	require.Equal(t, map[string]string{
+		"code":       "@upper",
+		"ptrCode":    "@upper?",
+		"shortCode":  "@upper & @string<length>[1,3]",
+		"level":      "@int<32>[1,5]",
+		"highLevel":  "@int<32>[4,5]",
+		"optionalLv": "@int<32>[1,5]?",
+	}, fields)
+
+	t.Run("passed", func(t *testing.T) {
+		require.NoError(t, v.Validate(Data{
+			Code:      "ABC",
+			ShortCode: "AB",
+			Level:     1,
+			HighLevel: 4,
+		}))
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		ptrCode := Code("abc")
+
+		err := v.Validate(Data{
+			Code:       "abc",
+			PtrCode:    &ptrCode,
+			ShortCode:  "ABCD",
+			Level:      6,
+			HighLevel:  1,
+			OptionalLv: 6,
+		})
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"code", "ptrCode", "shortCode", "level", "highLevel", "optionalLv"}, keyPaths)
+	})
+
+	t.Run("all errors of composed", func(t *testing.T) {
+		err := v.Validate(Data{
+			Code:      "ABC",
+			ShortCode: "abcd",
+			Level:     1,
+			HighLevel: 4,
+		})
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Flatten().Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"shortCode", "shortCode"}, keyPaths)
+	})
+
+	t.Run("describe composed", func(t *testing.T) {
+		require.Equal(t, "string in upper format, with 1–3 bytes", Describe(fields2validator(v, "ShortCode")).String())
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		require.NoError(t, f.RegisterTypeRule(reflect.TypeOf(Code("")), "@string[2]"))
+
+		v := f.MustCompile(context.Background(), nil, typesutil.FromRType(reflect.TypeOf(Code(""))))
+		require.Equal(t, "@string<length>[2]", v.String())
+
+		require.Error(t, f.RegisterTypeRule(reflect.TypeOf(Level(0)), "@int[1,"))
+		require.Panics(t, func() {
+			f.MustRegisterTypeRule(reflect.TypeOf(Level(0)), "@int[1,")
+		})
+	})
+}
+
+func fields2validator(v Validator, name string) Validator {
+	for _, f := range v.(*ValidatorLoader).Validator.(*StructValidator).Fields() {
+		if f.Name == name {
+			return f.Validator
+		}
+	}
+	return nil
+}
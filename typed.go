@@ -0,0 +1,207 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/go-courier/validator/errors"
+)
+
+/*
+Typed validates values of T by the validator compiled from T, created by For
+
+	v, err := validator.For[string]("@string[1,10]")
+	err = v.Validate("abc")
+
+	type Config struct {
+		Port int `json:"port,omitempty" default:"80" validate:"@int[1,65535]"`
+	}
+
+	c, err := validator.For[Config]()
+	err = c.ValidatePtr(&Config{}) // Port will be filled with 80
+*/
+type Typed[T any] struct {
+	validator Validator
+	// set when T is scalar and validated by built-in string, integer or float validators,
+	// values will be validated without reflect
+	scalar *ValidatorLoader
+	kind   reflect.Kind
+}
+
+// For compiles rule for T by ValidatorMgrDefault with named tag key `json`, compiled validators are cached.
+// Rule is optional, when not provided, T will be compiled as a field without `validate` tag, like `@struct` for structs.
+func For[T any](rule ...string) (*Typed[T], error) {
+	if len(rule) > 1 {
+		return nil, fmt.Errorf("For need at most 1 rule, but got %d", len(rule))
+	}
+
+	var ruleBytes []byte
+	if len(rule) == 1 {
+		ruleBytes = []byte(rule[0])
+	}
+
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+
+	v, err := ValidatorMgrDefault.CompileCached("json", ruleBytes, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := &Typed[T]{validator: v}
+
+	if loader, ok := v.(*ValidatorLoader); ok && isScalarLoader(loader) {
+		if hasScalarFastPath(loader.Validator, typ.Kind()) {
+			typed.scalar = loader
+			typed.kind = typ.Kind()
+		}
+	}
+
+	return typed, nil
+}
+
+// MustFor is same as For, but panics when rule is invalid
+func MustFor[T any](rule ...string) *Typed[T] {
+	typed, err := For[T](rule...)
+	if err != nil {
+		panic(err)
+	}
+	return typed
+}
+
+// Validator returns the compiled validator
+func (typed *Typed[T]) Validator() Validator {
+	return typed.validator
+}
+
+func (typed *Typed[T]) String() string {
+	return typed.validator.String()
+}
+
+// Validate validates v, default values will not be applied, use ValidatePtr instead
+func (typed *Typed[T]) Validate(v T) error {
+	if typed.scalar != nil {
+		return typed.validateScalar(v)
+	}
+	return typed.validator.Validate(v)
+}
+
+// ValidatePtr fills default values of ptr and validates it, nil ptr is missing
+func (typed *Typed[T]) ValidatePtr(ptr *T) error {
+	if ptr == nil {
+		return typed.validator.Validate(reflect.ValueOf(ptr))
+	}
+
+	if err := ApplyDefaultsBy(typed.validator, ptr); err != nil {
+		return err
+	}
+
+	if typed.scalar != nil {
+		return typed.validateScalar(*ptr)
+	}
+	return typed.validator.Validate(reflect.ValueOf(ptr).Elem())
+}
+
+// validateScalar validates v by the typed fast path, zero value is missing out of presence mode
+func (typed *Typed[T]) validateScalar(v T) error {
+	loader := typed.scalar
+	// T is in kind of typed.kind, which has same memory layout with the builtin type
+	p := unsafe.Pointer(&v)
+
+	switch x := loader.Validator.(type) {
+	case *StringValidator:
+		s := *(*string)(p)
+		if s == "" && !loader.Presence {
+			return loader.finalizeErr(typed.missing())
+		}
+		return loader.finalizeErr(x.validateString(s))
+	case *IntValidator:
+		n := readInt(p, typed.kind)
+		if n == 0 && !loader.Presence {
+			return loader.finalizeErr(typed.missing())
+		}
+		return loader.finalizeErr(x.validateInt(n))
+	case *UintValidator:
+		n := readUint(p, typed.kind)
+		if n == 0 && !loader.Presence {
+			return loader.finalizeErr(typed.missing())
+		}
+		return loader.finalizeErr(x.validateUint(n))
+	case *FloatValidator:
+		n := readFloat(p, typed.kind)
+		if n == 0 && !loader.Presence {
+			return loader.finalizeErr(typed.missing())
+		}
+		return loader.finalizeErr(x.validateFloat(n))
+	}
+
+	return loader.finalizeErr(loader.Validator.Validate(v))
+}
+
+func (typed *Typed[T]) missing() error {
+	if !typed.scalar.Optional {
+		return errors.MissingRequiredFieldError{}
+	}
+	return nil
+}
+
+// hasScalarFastPath returns true when values in kind could be validated by v without reflect
+func hasScalarFastPath(v Validator, kind reflect.Kind) bool {
+	switch v.(type) {
+	case *StringValidator:
+		return kind == reflect.String
+	case *IntValidator:
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true
+		}
+	case *UintValidator:
+		switch kind {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		}
+	case *FloatValidator:
+		return kind == reflect.Float32 || kind == reflect.Float64
+	}
+	return false
+}
+
+func readInt(p unsafe.Pointer, kind reflect.Kind) int64 {
+	switch kind {
+	case reflect.Int8:
+		return int64(*(*int8)(p))
+	case reflect.Int16:
+		return int64(*(*int16)(p))
+	case reflect.Int32:
+		return int64(*(*int32)(p))
+	case reflect.Int64:
+		return *(*int64)(p)
+	}
+	return int64(*(*int)(p))
+}
+
+func readUint(p unsafe.Pointer, kind reflect.Kind) uint64 {
+	switch kind {
+	case reflect.Uint8:
+		return uint64(*(*uint8)(p))
+	case reflect.Uint16:
+		return uint64(*(*uint16)(p))
+	case reflect.Uint32:
+		return uint64(*(*uint32)(p))
+	case reflect.Uint64:
+		return *(*uint64)(p)
+	}
+	return uint64(*(*uint)(p))
+}
+
+func readFloat(p unsafe.Pointer, kind reflect.Kind) float64 {
+	if kind == reflect.Float32 {
+		return float64(*(*float32)(p))
+	}
+	return *(*float64)(p)
+}
+
+// isScalarLoader returns true when values could be validated by the wrapped validator of loader directly
+func isScalarLoader(loader *ValidatorLoader) bool {
+	return loader.Validator != nil && loader.PreprocessStage == PreprocessSkip && loader.observer == nil
+}
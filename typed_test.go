@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type TypedConfig struct {
+	Host string `json:"host" validate:"@string[1,]"`
+	Port int    `json:"port,omitempty" default:"80" validate:"@int[1,65535]"`
+}
+
+func ExampleFor() {
+	v := MustFor[string]("@string[1,5]")
+
+	fmt.Println(v.Validate("abc"))
+	fmt.Println(v.Validate("abcdef"))
+	fmt.Println(v.Validate(""))
+	// Output:
+	// <nil>
+	// string length should be less or equal than 5, but got invalid value 6
+	// missing required field
+}
+
+func TestTyped(t *testing.T) {
+	t.Run("scalar", func(t *testing.T) {
+		v, err := For[int]("@int[1,10]")
+		require.NoError(t, err)
+		require.NotNil(t, v.scalar)
+		require.Equal(t, "@int<32>[1,10]", v.String())
+
+		require.NoError(t, v.Validate(1))
+		require.IsType(t, &errors.OutOfRangeError{}, v.Validate(11))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(0))
+	})
+
+	t.Run("scalar optional", func(t *testing.T) {
+		v := MustFor[float64]("@float64[1,10]?")
+		require.NoError(t, v.Validate(0))
+		require.Error(t, v.Validate(0.5))
+	})
+
+	t.Run("scalar presence", func(t *testing.T) {
+		v := MustFor[uint]("@uint[1,10]!")
+		require.IsType(t, &errors.OutOfRangeError{}, v.Validate(0))
+	})
+
+	t.Run("named scalar", func(t *testing.T) {
+		type Level string
+
+		v := MustFor[Level]("@string{LOW,HIGH}")
+		require.NotNil(t, v.scalar)
+		require.NoError(t, v.Validate("LOW"))
+		require.Error(t, v.Validate("MIDDLE"))
+	})
+
+	t.Run("scalar of kinds", func(t *testing.T) {
+		type Score float32
+
+		i8 := MustFor[int8]("@int8[-1,1]")
+		require.NotNil(t, i8.scalar)
+		require.NoError(t, i8.Validate(-1))
+		require.Error(t, i8.Validate(2))
+
+		u16 := MustFor[uint16]("@uint16{1,2}")
+		require.NotNil(t, u16.scalar)
+		require.NoError(t, u16.Validate(2))
+		require.Error(t, u16.Validate(3))
+
+		score := MustFor[Score]("@float32<3,1>[0,10]")
+		require.NotNil(t, score.scalar)
+		require.NoError(t, score.Validate(9.5))
+		require.Error(t, score.Validate(10.5))
+		require.Error(t, score.Validate(1.25))
+	})
+
+	t.Run("scalar without fast path", func(t *testing.T) {
+		v := MustFor[string]("@ip")
+		require.Nil(t, v.scalar)
+		require.NoError(t, v.Validate("127.0.0.1"))
+		require.Error(t, v.Validate("a"))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(""))
+	})
+
+	t.Run("scalar with err msg", func(t *testing.T) {
+		v := MustFor[string]("@string[1,]")
+		v.scalar.ErrMsg = []byte("name is required")
+		require.EqualError(t, v.Validate(""), "name is required")
+	})
+
+	t.Run("scalar ptr with default", func(t *testing.T) {
+		v := MustFor[int]("@int[1,10] = 5")
+
+		i := 0
+		require.NoError(t, v.ValidatePtr(&i))
+		require.Equal(t, 5, i)
+
+		i = 11
+		require.Error(t, v.ValidatePtr(&i))
+
+		require.NoError(t, v.ValidatePtr(nil))
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		v, err := For[TypedConfig]()
+		require.NoError(t, err)
+		require.Nil(t, v.scalar)
+
+		require.NoError(t, v.Validate(TypedConfig{Host: "localhost"}))
+		require.Error(t, v.Validate(TypedConfig{}))
+
+		c := TypedConfig{Host: "localhost"}
+		require.NoError(t, v.ValidatePtr(&c))
+		require.Equal(t, 80, c.Port)
+
+		require.Error(t, v.ValidatePtr(&TypedConfig{Host: "a", Port: 65536}))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.ValidatePtr(nil))
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		v := MustFor[[]string]("@slice<@string[1,]>[1,2]")
+		require.NoError(t, v.Validate([]string{"a"}))
+		require.Error(t, v.Validate([]string{"a", ""}))
+		require.Error(t, v.Validate([]string{"a", "b", "c"}))
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		v1 := MustFor[TypedConfig]()
+		v2 := MustFor[TypedConfig]()
+		require.Equal(t, reflect.ValueOf(v1.Validator()).Pointer(), reflect.ValueOf(v2.Validator()).Pointer())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := For[string]("@int")
+		require.Error(t, err)
+
+		_, err = For[string]("@string", "@string")
+		require.Error(t, err)
+
+		require.Panics(t, func() {
+			MustFor[string]("@int")
+		})
+	})
+}
+
+func BenchmarkTyped(b *testing.B) {
+	// values should not be constants, which are boxed without allocation
+	values := []int{100, 1000, 10000}
+
+	b.Run("For", func(b *testing.B) {
+		v := MustFor[int]("@int[1,65535]")
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = v.Validate(values[i%len(values)])
+		}
+	})
+
+	b.Run("Validator", func(b *testing.B) {
+		v := MustFor[int]("@int[1,65535]").Validator()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = v.Validate(values[i%len(values)])
+		}
+	})
+}
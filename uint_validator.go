@@ -106,8 +106,11 @@ func (validator *UintValidator) Validate(v interface{}) error {
 		return errors.NewUnsupportedTypeError(rv.Type().String(), validator.String())
 	}
 
-	val := rv.Uint()
+	return validator.validateUint(rv.Uint())
+}
 
+// validateUint validates uint value without reflect
+func (validator *UintValidator) validateUint(val uint64) error {
 	if validator.Enums != nil {
 		if _, ok := validator.Enums[val]; !ok {
 			values := make([]interface{}, 0)
@@ -279,3 +282,28 @@ func (validator *UintValidator) String() string {
 
 	return string(rule.Bytes())
 }
+
+func (validator *UintValidator) Describe() *Description {
+	d := &Description{Type: DescriptionTypeUint}
+
+	if validator.Enums != nil {
+		return d.add(enumConstraint(TargetUintValue, validator.Enums))
+	}
+
+	var minimum, maximum interface{}
+	// bounds of bit size are defaults
+	if validator.Minimum != 0 || validator.ExclusiveMinimum {
+		minimum = validator.Minimum
+	}
+	if validator.Maximum != MaxUint(validator.BitSize) {
+		maximum = validator.Maximum
+	}
+
+	d.add(rangeConstraint(TargetUintValue, minimum, maximum, validator.ExclusiveMinimum, validator.ExclusiveMaximum))
+
+	if validator.MultipleOf != 0 {
+		d.add(multipleOfConstraint(TargetUintValue, validator.MultipleOf))
+	}
+
+	return d
+}
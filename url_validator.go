@@ -0,0 +1,188 @@
+package validator
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/go-courier/validator/errors"
+	"github.com/go-courier/validator/rules"
+)
+
+var (
+	TargetURLScheme = "url scheme"
+	TargetURLHost   = "url host"
+)
+
+/*
+Validator for absolute URL, works for string and url.URL
+
+Rules:
+
+	@url
+	@url<https> // scheme should be https
+	@url<http,https>
+
+allow-list of hosts
+
+	@url{example.com,*.example.com} // hostname should be one of them, `*.` for any sub domains
+	@url<https>{example.com}
+*/
+type URLValidator struct {
+	Schemes map[string]bool
+	Hosts   map[string]bool
+}
+
+func init() {
+	ValidatorMgrDefault.Register(&URLValidator{})
+}
+
+func (URLValidator) Names() []string {
+	return []string{"url"}
+}
+
+func (validator *URLValidator) Validate(v interface{}) error {
+	var u *url.URL
+
+	switch x := indirectValue(v).(type) {
+	case url.URL:
+		u = &x
+	default:
+		s, err := stringOfTextValue(v, validator.String())
+		if err != nil {
+			return err
+		}
+		parsed, err := url.Parse(s)
+		if err != nil {
+			return &errors.TypeMismatchError{Expect: "URL", Current: s}
+		}
+		u = parsed
+	}
+
+	if !u.IsAbs() || u.Host == "" {
+		return &errors.TypeMismatchError{Expect: "absolute URL", Current: u.String()}
+	}
+
+	if len(validator.Schemes) > 0 && !validator.Schemes[strings.ToLower(u.Scheme)] {
+		return &errors.NotInEnumError{
+			Target:  TargetURLScheme,
+			Current: u.Scheme,
+			Enums:   keysAsEnums(validator.Schemes),
+		}
+	}
+
+	if len(validator.Hosts) > 0 && !allowDomain(validator.Hosts, strings.ToLower(u.Hostname())) {
+		return &errors.NotInEnumError{
+			Target:  TargetURLHost,
+			Current: u.Hostname(),
+			Enums:   keysAsEnums(validator.Hosts),
+		}
+	}
+
+	return nil
+}
+
+// allowDomain checks domain in set, `*.example.com` in set will match any sub domains of example.com
+func allowDomain(set map[string]bool, domain string) bool {
+	if set[domain] {
+		return true
+	}
+	for h := range set {
+		if strings.HasPrefix(h, "*.") && strings.HasSuffix(domain, h[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (URLValidator) New(ctx context.Context, rule *Rule) (Validator, error) {
+	validator := &URLValidator{}
+
+	for _, param := range rule.Params {
+		scheme := strings.ToLower(string(param.Bytes()))
+		if scheme == "" {
+			continue
+		}
+		if validator.Schemes == nil {
+			validator.Schemes = map[string]bool{}
+		}
+		validator.Schemes[scheme] = true
+	}
+
+	for _, v := range rule.ComputedValues() {
+		host := strings.ToLower(string(v.Bytes()))
+		if host == "" {
+			continue
+		}
+		if validator.Hosts == nil {
+			validator.Hosts = map[string]bool{}
+		}
+		validator.Hosts[host] = true
+	}
+
+	return validator, validator.TypeCheck(rule)
+}
+
+var rtypeURL = reflect.TypeOf(url.URL{})
+
+func (validator *URLValidator) TypeCheck(rule *Rule) error {
+	if rule.Type.Kind() == reflect.String || isRType(rule.Type, rtypeURL) {
+		return nil
+	}
+	return errors.NewUnsupportedTypeError(rule.String(), validator.String())
+}
+
+func (validator *URLValidator) String() string {
+	rule := rules.NewRule(validator.Names()[0])
+
+	for _, scheme := range sortedKeys(validator.Schemes) {
+		rule.Params = append(rule.Params, rules.NewRuleLit([]byte(scheme)))
+	}
+
+	if len(validator.Hosts) > 0 {
+		ruleValues := make([]*rules.RuleLit, 0, len(validator.Hosts))
+		for _, host := range sortedKeys(validator.Hosts) {
+			ruleValues = append(ruleValues, rules.NewRuleLit([]byte(host)))
+		}
+		rule.ValueMatrix = [][]*rules.RuleLit{ruleValues}
+	}
+
+	return string(rule.Bytes())
+}
+
+func (validator *URLValidator) Describe() *Description {
+	d := (&Description{Type: DescriptionTypeString}).add(&Constraint{
+		Code:   errors.CodeNotMatch,
+		Target: "url",
+		Params: map[string]interface{}{"format": "url"},
+	})
+
+	if len(validator.Schemes) > 0 {
+		d.add(enumListConstraint(TargetURLScheme, sortedKeys(validator.Schemes)))
+	}
+
+	if len(validator.Hosts) > 0 {
+		d.add(enumListConstraint(TargetURLHost, sortedKeys(validator.Hosts)))
+	}
+
+	return d
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func keysAsEnums(set map[string]bool) []interface{} {
+	values := make([]interface{}, 0, len(set))
+	for _, k := range sortedKeys(set) {
+		values = append(values, k)
+	}
+	return values
+}
@@ -0,0 +1,59 @@
+package validator
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestURLValidator(t *testing.T) {
+	mustParseURL := func(s string) *url.URL {
+		u, err := url.Parse(s)
+		require.NoError(t, err)
+		return u
+	}
+
+	cases := []struct {
+		rule    string
+		valid   []interface{}
+		invalid []interface{}
+	}{
+		{"@url", []interface{}{"http://example.com", mustParseURL("ftp://example.com/a"), *mustParseURL("https://x.io")}, []interface{}{"example.com", "/path", "http://", mustParseURL("mailto:a@b.c")}},
+		{"@url<https>", []interface{}{"https://example.com", "HTTPS://example.com"}, []interface{}{"http://example.com", mustParseURL("ftp://example.com")}},
+		{"@url<http,https>{example.com,*.example.org}", []interface{}{"https://example.com/a", "http://api.example.org:8080", "https://a.b.example.org"}, []interface{}{"https://example.org", "https://evil.com", "https://example.com.evil.com", "ftp://example.com"}},
+	}
+
+	for _, c := range cases {
+		for _, v := range c.valid {
+			v := v
+			t.Run(c.rule+" valid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				require.NoError(t, validator.Validate(v))
+			})
+		}
+		for _, v := range c.invalid {
+			v := v
+			t.Run(c.rule+" invalid", func(t *testing.T) {
+				validator := ValidatorMgrDefault.MustCompile(context.Background(), []byte(c.rule), typesutil.FromRType(reflect.TypeOf(v)))
+				err := validator.Validate(v)
+				require.Error(t, err)
+				t.Log(err)
+			})
+		}
+	}
+
+	t.Run("string", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@url<https,http>{example.com}"), typesutil.FromRType(reflect.TypeOf("")))
+		require.Equal(t, "@url<http,https>{example.com}", v.String())
+		require.Equal(t, "string in url format, one of http, https, one of example.com", Describe(v).String())
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := ValidatorMgrDefault.Compile(context.Background(), []byte("@url"), typesutil.FromRType(reflect.TypeOf(1)))
+		require.Error(t, err)
+	})
+}
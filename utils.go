@@ -2,9 +2,14 @@ package validator
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/go-courier/ptr"
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
 	"github.com/go-courier/validator/rules"
 )
 
@@ -83,3 +88,71 @@ func UintRange(typ string, bitSize uint, ranges ...*rules.RuleLit) (uint64, *uin
 	}
 	return 0, nil, nil
 }
+
+// stringOfTextValue returns string of value in kind string,
+// values of encoding.TextMarshaler are marshalled by ValidatorLoader already
+func stringOfTextValue(v interface{}, validatorName string) (string, error) {
+	rv, ok := v.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(v)
+	}
+	rv = reflectx.Indirect(rv)
+	if rv.Kind() != reflect.String {
+		return "", errors.NewUnsupportedTypeError(rv.Type().String(), validatorName)
+	}
+	return rv.String(), nil
+}
+
+// indirectValue returns the underlying value of reflect.Value or pointer
+func indirectValue(v interface{}) interface{} {
+	rv, ok := v.(reflect.Value)
+	if !ok {
+		rv = reflect.ValueOf(v)
+	}
+	rv = reflectx.Indirect(rv)
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+func isRType(typ typesutil.Type, rtype reflect.Type) bool {
+	t, ok := typ.(*typesutil.RType)
+	return ok && t.Type == rtype
+}
+
+// EnumValues returns values of Enums of validators, which are sorted by keys
+func EnumValues(enums interface{}) []string {
+	rv := reflect.ValueOf(enums)
+	keys := rv.MapKeys()
+
+	sort.Slice(keys, func(i, j int) bool {
+		switch keys[i].Kind() {
+		case reflect.Int64:
+			return keys[i].Int() < keys[j].Int()
+		case reflect.Uint64:
+			return keys[i].Uint() < keys[j].Uint()
+		case reflect.Float64:
+			return keys[i].Float() < keys[j].Float()
+		}
+		return keys[i].String() < keys[j].String()
+	})
+
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, rv.MapIndex(key).String())
+	}
+	return values
+}
+
+// UnwrapValidatorLoader returns the wrapped validator of ValidatorLoader,
+// validator of value wrapped by driver.Valuer will be unwrapped too
+func UnwrapValidatorLoader(validator Validator) Validator {
+	for {
+		loader, ok := validator.(*ValidatorLoader)
+		if !ok {
+			return validator
+		}
+		validator = loader.Validator
+	}
+}
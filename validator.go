@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
 	"github.com/go-courier/validator/rules"
 )
 
@@ -37,8 +39,9 @@ func ParseRuleWithType(ruleBytes []byte, typ typesutil.Type) (*Rule, error) {
 type Rule struct {
 	*rules.Rule
 
-	ErrMsg []byte
-	Type   typesutil.Type
+	ErrMsg   []byte
+	Severity errors.Severity
+	Type     typesutil.Type
 }
 
 func (r *Rule) String() string {
@@ -57,12 +60,21 @@ func (r *Rule) SetDefaultValue(defaultValue []byte) {
 	r.DefaultValue = defaultValue
 }
 
+func (r *Rule) SetSeverity(severity errors.Severity) {
+	r.Severity = severity
+}
+
 type RuleModifier interface {
 	SetOptional(optional bool)
 	SetDefaultValue(defaultValue []byte)
 	SetErrMsg(errMsg []byte)
 }
 
+// SeverityModifier could be implemented by RuleModifier to support severity of rule
+type SeverityModifier interface {
+	SetSeverity(severity errors.Severity)
+}
+
 type RuleProcessor = func(rule RuleModifier)
 
 // mgr for compiling validator
@@ -101,20 +113,153 @@ type Validator interface {
 func NewValidatorFactory() *ValidatorFactory {
 	return &ValidatorFactory{
 		validatorSet: map[string]ValidatorCreator{},
+		typeRules:    map[reflect.Type][]byte{},
+		defaultValueProviders: map[string]DefaultValueProvider{
+			"now":      DefaultValueProviderNow,
+			"uuid":     DefaultValueProviderUUID,
+			"sequence": NewDefaultValueProviderSequence(1),
+		},
 	}
 }
 
 type ValidatorFactory struct {
 	validatorSet map[string]ValidatorCreator
+	observer     ValidateObserver
+	parallelism  int
+	presence     bool
+	readOnly     bool
+	maxDepth     int
+
+	typeRules             map[reflect.Type][]byte
+	defaultValueProviders map[string]DefaultValueProvider
+
+	// guards options, registered validators, type rules, providers and cache,
+	// so the factory could be configured when compiling concurrently
+	mu sync.RWMutex
+	// changed when the factory configured, validators compiled before should not be cached
+	generation uint64
+	cache      map[compileCacheKey]Validator
+}
+
+// SetParallelism enables worker-pool mode of slice and map validators compiled after,
+// parallelism passed by ContextWithParallelism will take priority.
+func (f *ValidatorFactory) SetParallelism(workers int) {
+	f.configure(func() {
+		f.parallelism = workers
+	})
+}
+
+// SetObserver registers observer for validators compiled after,
+// observer passed by ContextWithValidateObserver will take priority.
+func (f *ValidatorFactory) SetObserver(observer ValidateObserver) {
+	f.configure(func() {
+		f.observer = observer
+	})
+}
+
+// SetPresenceMode enables presence mode for rules compiled after,
+// presence mode passed by ContextWithPresenceMode will take priority.
+func (f *ValidatorFactory) SetPresenceMode(enabled bool) {
+	f.configure(func() {
+		f.presence = enabled
+	})
+}
+
+// SetReadOnly makes validators compiled after side-effect free, default values should be filled by ApplyDefaults,
+// read-only passed by ContextWithReadOnly will take priority.
+func (f *ValidatorFactory) SetReadOnly(readOnly bool) {
+	f.configure(func() {
+		f.readOnly = readOnly
+	})
+}
+
+// SetMaxDepth sets max depth of nested values of recursive struct types for validators compiled after,
+// max depth passed by ContextWithMaxDepth will take priority.
+func (f *ValidatorFactory) SetMaxDepth(maxDepth int) {
+	f.configure(func() {
+		f.maxDepth = maxDepth
+	})
+}
+
+// RegisterDefaultValueProvider registers provider for default value `$name` of rules compiled after,
+// providers `$now`, `$uuid` and `$sequence` are registered by default.
+func (f *ValidatorFactory) RegisterDefaultValueProvider(name string, provider DefaultValueProvider) {
+	f.configure(func() {
+		f.defaultValueProviders[name] = provider
+	})
 }
 
 func (f *ValidatorFactory) Register(validators ...ValidatorCreator) {
-	for i := range validators {
-		validator := validators[i]
-		for _, name := range validator.Names() {
-			f.validatorSet[name] = validator
+	f.configure(func() {
+		for i := range validators {
+			validator := validators[i]
+			for _, name := range validator.Names() {
+				f.validatorSet[name] = validator
+			}
 		}
+	})
+}
+
+type compileCacheKey struct {
+	typ         reflect.Type
+	namedTagKey string
+	rule        string
+}
+
+// max count of validators cached by CompileCached
+const compileCacheSize = 1024
+
+// CompileCached compiles rule for typ with the named tag key, compiled validators will be cached.
+// Cached validators will be dropped when validators, type rules, default value providers or options of the factory changed,
+// and at most compileCacheSize validators will be cached, for rules created dynamically.
+func (f *ValidatorFactory) CompileCached(namedTagKey string, rule []byte, typ reflect.Type) (Validator, error) {
+	key := compileCacheKey{typ: typ, namedTagKey: namedTagKey, rule: string(rule)}
+
+	f.mu.RLock()
+	v, ok := f.cache[key]
+	generation := f.generation
+	f.mu.RUnlock()
+
+	if ok {
+		return v, nil
+	}
+
+	v, err := f.Compile(ContextWithNamedTagKey(context.Background(), namedTagKey), rule, typesutil.FromRType(typ))
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if generation != f.generation {
+		// configured when compiling
+		return v, nil
 	}
+
+	if f.cache == nil {
+		f.cache = map[compileCacheKey]Validator{}
+	}
+	if len(f.cache) >= compileCacheSize {
+		// drop any one
+		for k := range f.cache {
+			delete(f.cache, k)
+			break
+		}
+	}
+	f.cache[key] = v
+
+	return v, nil
+}
+
+// configure changes options or registries of the factory, cached validators will be dropped
+func (f *ValidatorFactory) configure(change func()) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	change()
+	f.generation++
+	f.cache = nil
 }
 
 func (f *ValidatorFactory) MustCompile(ctx context.Context, rule []byte, typ typesutil.Type, ruleProcessors ...RuleProcessor) Validator {
@@ -130,8 +275,14 @@ func (f *ValidatorFactory) Compile(ctx context.Context, ruleBytes []byte, typ ty
 		ctx = context.Background()
 	}
 
+	ctx, typeRule, hasTypeRule := f.prepare(ctx, typ)
+
+	if len(ruleBytes) == 0 && hasTypeRule {
+		ruleBytes = typeRule
+	}
+
 	if len(ruleBytes) == 0 {
-		if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); !ok {
+		if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); !ok && !isValuerType(typ) {
 			switch typesutil.Deref(typ).Kind() {
 			case reflect.Struct:
 				ruleBytes = []byte("@struct")
@@ -154,10 +305,90 @@ func (f *ValidatorFactory) Compile(ctx context.Context, ruleBytes []byte, typ ty
 		}
 	}
 
-	validatorCreator, ok := f.validatorSet[rule.Name]
+	validatorCreator, ok := f.validatorCreator(rule.Name)
 	if len(ruleBytes) != 0 && !ok {
 		return nil, fmt.Errorf("%s not match any validator", rule.Name)
 	}
 
-	return NewValidatorLoader(validatorCreator).New(ContextWithValidatorMgr(ctx, f), rule)
+	v, err := NewValidatorLoader(validatorCreator).New(ContextWithValidatorMgr(ctx, f), rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasTypeRule && string(typeRule) != string(ruleBytes) {
+		return f.composeTypeRule(ctx, v.(*ValidatorLoader), typeRule, typ)
+	}
+
+	return v, nil
+}
+
+// prepare injects options of the factory into ctx, and returns rule of typ registered or declared by ValidateRuler
+func (f *ValidatorFactory) prepare(ctx context.Context, typ typesutil.Type) (context.Context, []byte, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.observer != nil && ValidateObserverFromContext(ctx) == nil {
+		ctx = ContextWithValidateObserver(ctx, f.observer)
+	}
+
+	if f.parallelism > 0 && !isParallelismSet(ctx) {
+		ctx = ContextWithParallelism(ctx, f.parallelism)
+	}
+
+	if f.presence && !isPresenceModeSet(ctx) {
+		ctx = ContextWithPresenceMode(ctx, f.presence)
+	}
+
+	if f.readOnly && !isReadOnlySet(ctx) {
+		ctx = ContextWithReadOnly(ctx, f.readOnly)
+	}
+
+	if f.maxDepth > 0 && !isMaxDepthSet(ctx) {
+		ctx = ContextWithMaxDepth(ctx, f.maxDepth)
+	}
+
+	if len(f.defaultValueProviders) > 0 {
+		ctx = contextWithDefaultValueProviders(ctx, f.defaultValueProviders, false)
+	}
+
+	typeRule, hasTypeRule := f.typeRule(typ)
+	return ctx, typeRule, hasTypeRule
+}
+
+func (f *ValidatorFactory) validatorCreator(name string) (ValidatorCreator, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	creator, ok := f.validatorSet[name]
+	return creator, ok
+}
+
+// composeTypeRule composes rule of type when validator of field rule is not the same one
+func (f *ValidatorFactory) composeTypeRule(ctx context.Context, loader *ValidatorLoader, typeRule []byte, typ typesutil.Type) (Validator, error) {
+	r, err := ParseRuleWithType(typeRule, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if loader.Validator == nil {
+		return loader, nil
+	}
+
+	// aliases of validator
+	if creator, ok := f.validatorCreator(r.Name); ok && len(creator.Names()) > 0 && creator.Names()[0] == loader.name {
+		return loader, nil
+	}
+
+	typeValidator, err := f.Compile(ctx, typeRule, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if typeLoader, ok := typeValidator.(*ValidatorLoader); ok && typeLoader.Validator != nil {
+		loader.Validator = &AllOfValidator{
+			Validators: []Validator{typeLoader.Validator, loader.Validator},
+		}
+	}
+
+	return loader, nil
 }
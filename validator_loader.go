@@ -6,6 +6,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/go-courier/reflectx"
 	"github.com/go-courier/reflectx/typesutil"
@@ -27,6 +28,15 @@ type ValidatorLoader struct {
 	DefaultValue []byte
 	Optional     bool
 	ErrMsg       []byte
+	Severity     errors.Severity
+	// only nil values are missing, zero values will be validated
+	Presence bool
+
+	name     string
+	observer ValidateObserver
+	readOnly bool
+	// bound provider when default value is `$name`
+	provideDefaultValue func(typ reflect.Type) (interface{}, error)
 }
 
 type PreprocessStage int
@@ -35,6 +45,8 @@ const (
 	PreprocessSkip PreprocessStage = iota
 	PreprocessString
 	PreprocessPtr
+	// values of driver.Valuer, like sql.NullString, will be unwrapped
+	PreprocessValuer
 )
 
 func normalize(typ typesutil.Type) (typesutil.Type, PreprocessStage) {
@@ -51,6 +63,15 @@ func (loader *ValidatorLoader) String() string {
 	if loader.Validator != nil {
 		v := loader.Validator.String()
 
+		if elem, ok := loader.Validator.(*ValidatorLoader); ok && loader.PreprocessStage == PreprocessValuer {
+			// presence of wrapped value is implicit
+			v = elem.Validator.String()
+		}
+
+		if loader.Presence {
+			v += "!"
+		}
+
 		if loader.Optional {
 			if loader.DefaultValue != nil {
 				return v + " = " + string(rules.SingleQuote(loader.DefaultValue))
@@ -63,30 +84,99 @@ func (loader *ValidatorLoader) String() string {
 	return "nil"
 }
 
+// Describe returns description of wrapped validator with optional and default value, nil when no validator
+func (loader *ValidatorLoader) Describe() *Description {
+	d := Describe(loader.Validator)
+	if d == nil {
+		return nil
+	}
+	d.Optional = loader.Optional
+	if loader.DefaultValue != nil {
+		d.DefaultValue = string(loader.DefaultValue)
+	}
+	return d
+}
+
+// ZeroValidated returns true when zero values will be validated instead of being missing,
+// in presence mode or for values of driver.Valuer, which are missing only when nil or invalid (NULL)
+func (loader *ValidatorLoader) ZeroValidated() bool {
+	return loader.Presence || loader.PreprocessStage == PreprocessValuer
+}
+
+// LiteralDefaultValue returns default value as literal, with leading `$$` unescaped as `$`,
+// nil when no default value or it is provided by DefaultValueProvider
+func (loader *ValidatorLoader) LiteralDefaultValue() []byte {
+	if loader.DefaultValue == nil || loader.provideDefaultValue != nil {
+		return nil
+	}
+	return unescapeDefaultValue(loader.DefaultValue)
+}
+
+// DefaultValueProviderName returns name of DefaultValueProvider which provides default value, like `now` of `$now`
+func (loader *ValidatorLoader) DefaultValueProviderName() (string, bool) {
+	if loader.provideDefaultValue == nil {
+		return "", false
+	}
+	return defaultValueProviderName(loader.DefaultValue)
+}
+
 func (loader *ValidatorLoader) New(ctx context.Context, rule *Rule) (Validator, error) {
 	l := NewValidatorLoader(loader.ValidatorCreator)
 
 	l.Optional = rule.Optional
 	l.DefaultValue = rule.DefaultValue
 	l.ErrMsg = rule.ErrMsg
+	l.Severity = rule.Severity
+	l.Presence = rule.Presence || PresenceModeFromContext(ctx)
+	l.observer = ValidateObserverFromContext(ctx)
+	l.readOnly = ReadOnlyFromContext(ctx)
 
 	typ := rule.Type
 
 	rule.Type, l.PreprocessStage = normalize(rule.Type)
 
+	elemType, isValuer := ValuerElemType(typ)
+	if isValuer {
+		l.PreprocessStage = PreprocessValuer
+	}
+
+	if name, ok := defaultValueProviderName(l.DefaultValue); ok {
+		// default values like `$5` are literals when provider not registered
+		if provider, ok := DefaultValueProviderFromContext(ctx, name); ok {
+			l.provideDefaultValue = func(typ reflect.Type) (interface{}, error) {
+				return provider(ctx, typ)
+			}
+		}
+	}
+
 	if loader.ValidatorCreator != nil {
-		v, err := loader.ValidatorCreator.New(ctx, rule)
+		if names := loader.ValidatorCreator.Names(); len(names) > 0 {
+			l.name = names[0]
+		}
+
+		var v Validator
+		var err error
+
+		if isValuer {
+			v, err = loader.newValuerElemValidator(ctx, rule, elemType)
+		} else {
+			v, err = loader.ValidatorCreator.New(ctx, rule)
+		}
 		if err != nil {
 			return nil, err
 		}
 		l.Validator = v
 
-		if l.DefaultValue != nil {
+		if l.DefaultValue != nil && l.provideDefaultValue == nil {
 			if rv, ok := typesutil.TryNew(typ); ok {
-				if err := reflectx.UnmarshalText(rv, l.DefaultValue); err != nil {
+				if err := l.setDefaultValue(rv); err != nil {
 					return nil, fmt.Errorf("default value `%s` can not unmarshal to %s: %s", l.DefaultValue, typ, err)
 				}
-				if err := l.Validate(rv); err != nil {
+				// zero defaults of structs or arrays should be validated instead of being filled again
+				l.readOnly = true
+				err := l.Validate(rv)
+				l.readOnly = ReadOnlyFromContext(ctx)
+				if err != nil {
 					return nil, fmt.Errorf("default value `%s` is not a valid value of %s: %s", l.DefaultValue, v, err)
 				}
 			}
@@ -96,33 +186,83 @@ func (loader *ValidatorLoader) New(ctx context.Context, rule *Rule) (Validator,
 	return l, nil
 }
 
+// newValuerElemValidator creates validator for the value wrapped by driver.Valuer.
+// Valid wrapped values are always present, so zero values will be validated too.
+func (loader *ValidatorLoader) newValuerElemValidator(ctx context.Context, rule *Rule, elemType typesutil.Type) (Validator, error) {
+	elemRule := *rule.Rule
+	elemRule.Optional = false
+	elemRule.DefaultValue = nil
+	elemRule.Presence = true
+
+	v, err := NewValidatorLoader(loader.ValidatorCreator).New(ctx, &Rule{Rule: &elemRule, Type: elemType})
+	if err != nil {
+		return nil, err
+	}
+	// events are reported by the outer loader
+	v.(*ValidatorLoader).observer = nil
+	return v, nil
+}
+
 func (loader *ValidatorLoader) Validate(v interface{}) error {
-	err := loader.validate(v)
+	return loader.validateInScope(v, rootScopeIf(loader.observer != nil))
+}
+
+func (loader *ValidatorLoader) validateInScope(v interface{}, s *scope) error {
+	if loader.observer == nil {
+		return loader.finalizeErr(loader.validate(v, s))
+	}
+
+	startedAt := time.Now()
+	err := loader.finalizeErr(loader.validate(v, s))
+
+	loader.observer.OnValidate(&ValidateEvent{
+		Validator: loader.name,
+		KeyPath:   s.KeyPath(),
+		Duration:  time.Since(startedAt),
+		Error:     err,
+	})
+
+	return err
+}
+
+func (loader *ValidatorLoader) finalizeErr(err error) error {
 	if err == nil {
 		return nil
 	}
 	if loader.ErrMsg != nil && len(loader.ErrMsg) != 0 {
-		return stderrors.New(string(loader.ErrMsg))
+		err = stderrors.New(string(loader.ErrMsg))
+	}
+	if loader.Severity == errors.SeverityWarning {
+		// warning should not fail the validation, but still be reported
+		return errors.NewWarning(err)
 	}
 	return err
 }
 
-func (loader *ValidatorLoader) validate(v interface{}) error {
+func (loader *ValidatorLoader) validate(v interface{}, s *scope) error {
 	rv, ok := v.(reflect.Value)
 	if !ok {
 		rv = reflect.ValueOf(v)
 	}
 
-	if reflectx.IsEmptyValue(rv) {
-		if !loader.Optional {
-			return errors.MissingRequiredFieldError{}
+	if !loader.readOnly && loader.shouldApplyDefault(rv) {
+		err := loader.setDefaultValue(rv)
+		if err != nil {
+			return fmt.Errorf("unmarshal default value failed")
+		}
+		// default value is validated when compiling, but provided values are not
+		if loader.provideDefaultValue == nil {
+			return nil
 		}
+	}
 
-		if loader.DefaultValue != nil && rv.CanSet() {
-			err := reflectx.UnmarshalText(rv, loader.DefaultValue)
-			if err != nil {
-				return fmt.Errorf("unmarshal default value failed")
-			}
+	return loader.validateValue(rv, s)
+}
+
+func (loader *ValidatorLoader) validateValue(rv reflect.Value, s *scope) error {
+	if loader.isMissing(rv) {
+		if !loader.Optional {
+			return errors.MissingRequiredFieldError{}
 		}
 		// empty value should not to validate
 		return nil
@@ -132,8 +272,14 @@ func (loader *ValidatorLoader) validate(v interface{}) error {
 		return nil
 	}
 
+	if loader.PreprocessStage == PreprocessValuer {
+		elem, _ := unwrapValuer(rv)
+		return validateInScope(loader.Validator, elem, s)
+	}
+
 	if loader.PreprocessStage == PreprocessString {
 		// make sure value over reflect.Value
+		var v interface{} = rv
 		if rv.CanInterface() {
 			v = rv.Interface()
 		}
@@ -151,5 +297,17 @@ func (loader *ValidatorLoader) validate(v interface{}) error {
 		rv = rv.Elem()
 	}
 
-	return loader.Validator.Validate(reflectx.Indirect(rv))
+	return validateInScope(loader.Validator, reflectx.Indirect(rv), s)
+}
+
+// isMissing returns true when rv is missing, invalid values (NULL) of driver.Valuer are missing too
+func (loader *ValidatorLoader) isMissing(rv reflect.Value) bool {
+	if isMissing(rv, loader.Presence) {
+		return true
+	}
+	if loader.PreprocessStage == PreprocessValuer {
+		_, valid := unwrapValuer(rv)
+		return !valid
+	}
+	return false
 }
@@ -0,0 +1,70 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileCached(t *testing.T) {
+	f := NewValidatorFactory()
+	f.Register(&StringValidator{})
+
+	typ := reflect.TypeOf("")
+
+	v1, err := f.CompileCached("json", []byte("@string[1,]"), typ)
+	require.NoError(t, err)
+
+	v2, err := f.CompileCached("json", []byte("@string[1,]"), typ)
+	require.NoError(t, err)
+	require.True(t, v1 == v2)
+
+	v3, err := f.CompileCached("json", []byte("@string[2,]"), typ)
+	require.NoError(t, err)
+	require.False(t, v1 == v3)
+
+	t.Run("reset when factory changed", func(t *testing.T) {
+		f.SetPresenceMode(true)
+
+		v, err := f.CompileCached("json", []byte("@string[1,]"), typ)
+		require.NoError(t, err)
+		require.False(t, v1 == v)
+		require.True(t, v.(*ValidatorLoader).Presence)
+	})
+
+	t.Run("bounded", func(t *testing.T) {
+		for i := 0; i < compileCacheSize+10; i++ {
+			_, err := f.CompileCached("json", []byte(fmt.Sprintf("@string[%d,]", i)), typ)
+			require.NoError(t, err)
+		}
+		require.Len(t, f.cache, compileCacheSize)
+	})
+
+	t.Run("configured concurrently", func(t *testing.T) {
+		wg := sync.WaitGroup{}
+
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+
+			go func(i int) {
+				defer wg.Done()
+				f.SetMaxDepth(i)
+				f.RegisterDefaultValueProvider("value", func(ctx context.Context, typ reflect.Type) (interface{}, error) {
+					return "value", nil
+				})
+			}(i)
+
+			go func() {
+				defer wg.Done()
+				_, err := f.CompileCached("json", []byte("@string[1,] = '$value'"), typ)
+				require.NoError(t, err)
+			}()
+		}
+
+		wg.Wait()
+	})
+}
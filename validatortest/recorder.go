@@ -0,0 +1,52 @@
+package validatortest
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/go-courier/validator"
+)
+
+// Recorder is a validator.ValidateObserver which records all events for assertions in tests
+type Recorder struct {
+	mu     sync.Mutex
+	events []*validator.ValidateEvent
+}
+
+func (r *Recorder) OnValidate(event *validator.ValidateEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// Events returns recorded events in order of evaluations finished
+func (r *Recorder) Events() []*validator.ValidateEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	events := make([]*validator.ValidateEvent, len(r.events))
+	copy(events, r.events)
+	return events
+}
+
+// Failed returns sorted failed evaluations, like `Slice[1].Name @string`
+func (r *Recorder) Failed() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	failed := make([]string, 0)
+	for _, e := range r.events {
+		if e.Error != nil {
+			failed = append(failed, e.KeyPath.String()+" @"+e.Validator)
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
+
+// Reset drops recorded events
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = nil
+}
@@ -0,0 +1,42 @@
+package validatortest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+)
+
+func ExampleRecorder() {
+	type Sub struct {
+		Name string `validate:"@string[1,]"`
+	}
+
+	type SomeStruct struct {
+		Int   int   `validate:"@int[1,10]"`
+		Slice []Sub `validate:"@slice[1,]"`
+	}
+
+	recorder := &Recorder{}
+
+	ctx := validator.ContextWithValidateObserver(context.Background(), recorder)
+	v := validator.ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(SomeStruct{})))
+
+	_ = v.Validate(SomeStruct{Int: 11, Slice: []Sub{{Name: "a"}, {}}})
+	for _, failed := range recorder.Failed() {
+		fmt.Println(failed)
+	}
+
+	recorder.Reset()
+	_ = v.Validate(SomeStruct{Int: 1, Slice: []Sub{{Name: "a"}}})
+	fmt.Println(len(recorder.Events()), len(recorder.Failed()))
+	// Output:
+	//  @struct
+	// Int @int
+	// Slice @slice
+	// Slice[1] @struct
+	// Slice[1].Name @string
+	// 5 0
+}
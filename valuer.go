@@ -0,0 +1,126 @@
+package validator
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/go-courier/reflectx"
+	"github.com/go-courier/reflectx/typesutil"
+)
+
+var (
+	rtypeDriverValuer = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	rtypeSQLScanner   = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// isValuerType returns true when typ (or pointer of typ) implements driver.Valuer but not encoding.TextMarshaler
+func isValuerType(typ typesutil.Type) bool {
+	_, ok := ValuerElemType(typ)
+	return ok
+}
+
+/*
+ValuerElemType returns the type of value wrapped by driver.Valuer, false when typ is not a driver.Valuer.
+
+For types like sql.NullString, sql.NullInt64 (struct with two fields, and the last one is `Valid bool`),
+it will be the type of the first field.
+For others, it will be the type of value returned by Value() of zero value,
+or the driver.Value type of its kind when nil returned, like int64 for `type Status int`.
+*/
+func ValuerElemType(typ typesutil.Type) (typesutil.Type, bool) {
+	rtype, ok := typesutil.Deref(typ).(*typesutil.RType)
+	if !ok {
+		return nil, false
+	}
+
+	if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(rtype); ok {
+		return nil, false
+	}
+
+	t := rtype.Type
+
+	if !t.Implements(rtypeDriverValuer) && !reflect.PtrTo(t).Implements(rtypeDriverValuer) {
+		return nil, false
+	}
+
+	if field, ok := nullableValueField(t); ok {
+		return typesutil.FromRType(field.Type), true
+	}
+
+	if v, err := valuerOf(reflect.New(t).Elem()).Value(); err == nil && v != nil {
+		return typesutil.FromRType(reflect.TypeOf(v)), true
+	}
+
+	return typesutil.FromRType(driverValueType(t.Kind())), true
+}
+
+func driverValueType(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Bool:
+		return reflect.TypeOf(false)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Float32, reflect.Float64:
+		return reflect.TypeOf(float64(0))
+	}
+	return reflect.TypeOf("")
+}
+
+func nullableValueField(t reflect.Type) (reflect.StructField, bool) {
+	if t.Kind() == reflect.Struct && t.NumField() == 2 {
+		if valid := t.Field(1); valid.Name == "Valid" && valid.Type.Kind() == reflect.Bool {
+			return t.Field(0), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// unwrapValuer returns the value wrapped by driver.Valuer and whether it is valid (not NULL)
+func unwrapValuer(rv reflect.Value) (reflect.Value, bool) {
+	rv = reflectx.Indirect(rv)
+
+	if !rv.IsValid() {
+		return rv, false
+	}
+
+	if _, ok := nullableValueField(rv.Type()); ok {
+		return rv.Field(0), rv.Field(1).Bool()
+	}
+
+	v, err := valuerOf(rv).Value()
+	if err != nil || v == nil {
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(v), true
+}
+
+func valuerOf(rv reflect.Value) driver.Valuer {
+	if valuer, ok := rv.Interface().(driver.Valuer); ok {
+		return valuer
+	}
+	// method with pointer receiver
+	if !rv.CanAddr() {
+		ptr := reflect.New(rv.Type())
+		ptr.Elem().Set(rv)
+		rv = ptr.Elem()
+	}
+	return rv.Addr().Interface().(driver.Valuer)
+}
+
+// scanValue sets text to rv by sql.Scanner, returns false when rv is not a sql.Scanner
+func scanValue(rv reflect.Value, data []byte) (bool, error) {
+	if !reflect.PtrTo(reflectx.Deref(rv.Type())).Implements(rtypeSQLScanner) {
+		return false, nil
+	}
+
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		rv = rv.Elem()
+	}
+
+	return true, rv.Addr().Interface().(sql.Scanner).Scan(string(data))
+}
@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type Status int
+
+func (s Status) Value() (driver.Value, error) {
+	if s == 0 {
+		return nil, nil
+	}
+	return int64(s), nil
+}
+
+type Tags []string
+
+func (tags *Tags) Value() (driver.Value, error) {
+	if len(*tags) == 0 {
+		return nil, nil
+	}
+	return "tags", nil
+}
+
+func TestValuer(t *testing.T) {
+	t.Run("sql.NullString", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@string[1,10]"), typesutil.FromRType(reflect.TypeOf(sql.NullString{})))
+		require.Equal(t, "@string<length>[1,10]", v.String())
+
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(sql.NullString{}))
+		require.NoError(t, v.Validate(sql.NullString{String: "abc", Valid: true}))
+		// valid empty string is present, and should be validated
+		require.Error(t, v.Validate(sql.NullString{Valid: true}))
+		require.Error(t, v.Validate(sql.NullString{String: "abcdefghijk", Valid: true}))
+	})
+
+	t.Run("optional sql.NullInt64", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int64[1,10]?"), typesutil.FromRType(reflect.TypeOf(&sql.NullInt64{})))
+		require.Equal(t, "@int<64>[1,10]?", v.String())
+
+		require.NoError(t, v.Validate((*sql.NullInt64)(nil)))
+		require.NoError(t, v.Validate(&sql.NullInt64{}))
+		require.NoError(t, v.Validate(&sql.NullInt64{Int64: 1, Valid: true}))
+		require.Error(t, v.Validate(&sql.NullInt64{Int64: 11, Valid: true}))
+	})
+
+	t.Run("sql.NullTime", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@string[1,]"), typesutil.FromRType(reflect.TypeOf(sql.NullTime{})))
+		require.NoError(t, v.Validate(sql.NullTime{Valid: true}))
+		require.Error(t, v.Validate(sql.NullTime{}))
+	})
+
+	t.Run("custom valuer", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@int64[1,3]"), typesutil.FromRType(reflect.TypeOf(Status(0))))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(Status(0)))
+		require.NoError(t, v.Validate(Status(2)))
+		require.Error(t, v.Validate(Status(4)))
+	})
+
+	t.Run("custom valuer with pointer receiver", func(t *testing.T) {
+		v := ValidatorMgrDefault.MustCompile(context.Background(), []byte("@string{tags}"), typesutil.FromRType(reflect.TypeOf(Tags{})))
+		require.Equal(t, errors.MissingRequiredFieldError{}, v.Validate(Tags{}))
+		require.NoError(t, v.Validate(Tags{"a"}))
+	})
+
+	t.Run("in struct", func(t *testing.T) {
+		type Data struct {
+			Name     sql.NullString  `json:"name" validate:"@string[1,10]"`
+			Nickname sql.NullString  `json:"nickname,omitempty" validate:"@string[1,10]"`
+			Age      sql.NullInt64   `json:"age,omitempty" validate:"@int64[0,150]"`
+			Score    sql.NullFloat64 `json:"score,omitempty"`
+		}
+
+		ctx := ContextWithNamedTagKey(context.Background(), "json")
+		v := ValidatorMgrDefault.MustCompile(ctx, nil, typesutil.FromRType(reflect.TypeOf(Data{})))
+
+		require.NoError(t, v.Validate(Data{Name: sql.NullString{String: "a", Valid: true}}))
+
+		err := v.Validate(Data{
+			Nickname: sql.NullString{Valid: true},
+			Age:      sql.NullInt64{Int64: 151, Valid: true},
+		})
+		require.Error(t, err)
+
+		keyPaths := make([]string, 0)
+		err.(*errors.ErrorSet).Each(func(fieldErr *errors.FieldError) {
+			keyPaths = append(keyPaths, fieldErr.Field.String())
+		})
+		require.Equal(t, []string{"name", "nickname", "age"}, keyPaths)
+	})
+
+	t.Run("default value", func(t *testing.T) {
+		type Data struct {
+			Name sql.NullString `json:"name,omitempty" default:"abc" validate:"@string[1,10]"`
+			Age  sql.NullInt64  `json:"age,omitempty" default:"18" validate:"@int64[0,150]"`
+		}
+
+		data := Data{Age: sql.NullInt64{Int64: 20, Valid: true}}
+		require.NoError(t, ApplyDefaults(&data))
+		require.Equal(t, Data{
+			Name: sql.NullString{String: "abc", Valid: true},
+			Age:  sql.NullInt64{Int64: 20, Valid: true},
+		}, data)
+	})
+
+	t.Run("unwrap", func(t *testing.T) {
+		v, err := ValidatorMgrDefault.Compile(context.Background(), []byte("@string[1,10]"), typesutil.FromRType(reflect.TypeOf(sql.NullString{})))
+		require.NoError(t, err)
+		require.Equal(t, PreprocessValuer, v.(*ValidatorLoader).PreprocessStage)
+		require.True(t, v.(*ValidatorLoader).ZeroValidated())
+
+		_, ok := UnwrapValidatorLoader(v).(*StringValidator)
+		require.True(t, ok)
+
+		elemType, ok := ValuerElemType(typesutil.FromRType(reflect.TypeOf(sql.NullString{})))
+		require.True(t, ok)
+		require.Equal(t, reflect.String, elemType.Kind())
+	})
+
+	t.Run("invalid default value", func(t *testing.T) {
+		_, err := ValidatorMgrDefault.Compile(context.Background(), []byte("@string[1,2] = 'abc'"), typesutil.FromRType(reflect.TypeOf(sql.NullString{})))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,469 @@
+package zodgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-courier/reflectx/typesutil"
+	"github.com/go-courier/validator"
+	"github.com/go-courier/validator/internal/ecmascript"
+)
+
+func NewGenerator(namedTagKey string) *Generator {
+	return &Generator{
+		NamedTagKey: namedTagKey,
+		declared:    map[string]bool{},
+		declaring:   map[string]*decl{},
+	}
+}
+
+/*
+Generator generates TypeScript interfaces and Zod schemas from compiled validators of named struct types.
+
+Each named struct will be declared as
+
+	export const NameSchema = z.object({ ... });
+	export interface Name { ... }
+
+Nested named structs are declared before the ones using them,
+references back to recursive types are z.lazy() with explicit type annotation of the schema.
+Empty values are handled as the server does:
+required fields reject zero values, and zero values of optional fields skip other rules.
+*/
+type Generator struct {
+	NamedTagKey string
+
+	decls    []*decl
+	declared map[string]bool
+	// decls in progress, which could be referred by recursive types
+	declaring map[string]*decl
+}
+
+type decl struct {
+	name   string
+	schema string
+	iface  string
+	// referred by itself or types in it
+	recursive bool
+}
+
+// Add compiles named struct type and declares it
+func (g *Generator) Add(typ reflect.Type) error {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ.Kind() != reflect.Struct || typ.Name() == "" {
+		return fmt.Errorf("%s should be a named struct", typ)
+	}
+
+	ctx := validator.ContextWithNamedTagKey(context.Background(), g.NamedTagKey)
+	v, err := validator.ValidatorMgrDefault.Compile(ctx, nil, typesutil.FromRType(typ))
+	if err != nil {
+		return err
+	}
+
+	g.declare(typesutil.FromRType(typ), validator.UnwrapValidatorLoader(v).(*validator.StructValidator))
+	return nil
+}
+
+func (g *Generator) WriteTo(w io.Writer) (int64, error) {
+	buf := bytes.NewBufferString("import { z } from \"zod\";\n")
+
+	for _, d := range g.decls {
+		if d.recursive {
+			// type of recursive schema could not be inferred
+			_, _ = fmt.Fprintf(buf, "\nexport const %sSchema: z.ZodType<%s> = %s;\n", d.name, d.name, d.schema)
+		} else {
+			_, _ = fmt.Fprintf(buf, "\nexport const %sSchema = %s;\n", d.name, d.schema)
+		}
+		_, _ = fmt.Fprintf(buf, "\nexport interface %s %s\n", d.name, d.iface)
+	}
+
+	return buf.WriteTo(w)
+}
+
+func (g *Generator) String() string {
+	b := &strings.Builder{}
+	_, _ = g.WriteTo(b)
+	return b.String()
+}
+
+// declare declares named struct and returns schema referring to it
+func (g *Generator) declare(typ typesutil.Type, structValidator *validator.StructValidator) string {
+	name := typ.Name()
+
+	if d, ok := g.declaring[name]; ok {
+		// schema is not initialized yet
+		d.recursive = true
+		return "z.lazy(() => " + name + "Schema)"
+	}
+	if g.declared[name] {
+		return name + "Schema"
+	}
+	g.declared[name] = true
+
+	d := &decl{name: name}
+	g.declaring[name] = d
+	d.schema, d.iface = g.object(structValidator, "")
+	delete(g.declaring, name)
+
+	g.decls = append(g.decls, d)
+	return name + "Schema"
+}
+
+func (g *Generator) object(structValidator *validator.StructValidator, indent string) (string, string) {
+	schema := bytes.NewBufferString("z.object({\n")
+	iface := bytes.NewBufferString("{\n")
+
+	for _, field := range structValidator.Fields() {
+		s := g.value(field.Type, field.Validator, indent+"  ")
+
+		key := field.DisplayName
+		if !reIdentifier.MatchString(key) {
+			key = literal(key)
+		}
+
+		_, _ = fmt.Fprintf(schema, "%s  %s: %s,\n", indent, key, s.zod)
+
+		if s.optional {
+			key += "?"
+		}
+		_, _ = fmt.Fprintf(iface, "%s  %s: %s;\n", indent, key, s.ts)
+	}
+
+	schema.WriteString(indent + "})")
+	iface.WriteString(indent + "}")
+
+	return schema.String(), iface.String()
+}
+
+var reIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+type schema struct {
+	zod string
+	ts  string
+	// literal of zero value, empty for types without zero value to check
+	zero string
+	// zero value will be rejected by rules
+	rejectsZero bool
+	optional    bool
+	// schema of literals, like z.enum, which has no .min()
+	literals bool
+}
+
+func (g *Generator) value(typ typesutil.Type, v validator.Validator, indent string) *schema {
+	loader, _ := v.(*validator.ValidatorLoader)
+	if loader != nil {
+		v = validator.UnwrapValidatorLoader(loader)
+	}
+
+	baseType := typ
+	elemType, isValuer := validator.ValuerElemType(typ)
+	if isValuer {
+		baseType = elemType
+	}
+
+	s := g.base(baseType, v, indent)
+
+	if loader == nil {
+		return s
+	}
+
+	if loader.Optional {
+		// nil pointer is the empty value, pointer to zero value will be checked by other rules,
+		// and so do zero values in presence mode or of driver.Valuer
+		if s.rejectsZero && typ.Kind() != reflect.Ptr && !loader.ZeroValidated() {
+			// empty value will skip other rules
+			switch s.zero {
+			case "":
+			case "[]":
+				s.zod += ".or(z.tuple([]))"
+			case "{}":
+				s.zod += ".or(z.object({}).strict())"
+			default:
+				s.zod += ".or(z.literal(" + s.zero + "))"
+				s.ts += " | " + s.zero
+			}
+		}
+		if isValuer {
+			// null of driver.Valuer is missing
+			s.zod += ".nullable()"
+			s.ts += " | null"
+		}
+		// default values provided by DefaultValueProvider are computed by server
+		if defaultValue := loader.LiteralDefaultValue(); defaultValue != nil {
+			s.zod += ".default(" + defaultLiteral(typ, defaultValue) + ")"
+		} else {
+			s.zod += ".optional()"
+		}
+		s.optional = true
+		return s
+	}
+
+	if !s.rejectsZero && !loader.ZeroValidated() {
+		// empty value of required field is missing, only null or undefined is missing in presence mode
+		switch s.zero {
+		case `""`, "[]":
+			if s.literals {
+				s.zod += ".refine((v) => v !== " + s.zero + `, { message: "missing required field" })`
+				break
+			}
+			s.zod += ".min(1)"
+		case "{}":
+			s.zod += `.refine((m) => Object.keys(m).length > 0, { message: "missing required field" })`
+		case "":
+		default:
+			s.zod += ".refine((v) => v !== " + s.zero + `, { message: "missing required field" })`
+		}
+	}
+
+	return s
+}
+
+func (g *Generator) base(typ typesutil.Type, v validator.Validator, indent string) *schema {
+	if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); ok && v == nil {
+		return &schema{zod: "z.string()", ts: "string", zero: `""`}
+	}
+
+	typ = typesutil.Deref(typ)
+
+	switch x := v.(type) {
+	case *validator.StringValidator:
+		s := &schema{zod: "z.string()", ts: "string", zero: `""`}
+
+		if x.Enums != nil {
+			values := validator.EnumValues(x.Enums)
+			for i := range values {
+				values[i] = literal(values[i])
+			}
+			s.zod = "z.enum([" + strings.Join(values, ", ") + "])"
+			s.ts = strings.Join(values, " | ")
+			_, ok := x.Enums[""]
+			s.rejectsZero = !ok
+			s.literals = true
+			return s
+		}
+
+		if x.Pattern != nil {
+			s.zod += regex(x.Pattern)
+			s.rejectsZero = !x.Pattern.MatchString("")
+			return s
+		}
+
+		if x.MinLength > 0 {
+			s.zod += ".min(" + strconv.FormatUint(x.MinLength, 10) + ")"
+			s.rejectsZero = true
+		}
+		if x.MaxLength != nil {
+			s.zod += ".max(" + strconv.FormatUint(*x.MaxLength, 10) + ")"
+		}
+		return s
+	case *validator.StrfmtValidator:
+		s := &schema{zod: "z.string()", ts: "string", zero: `""`, rejectsZero: true}
+		if re := x.Pattern(); re != nil {
+			s.zod += regex(re)
+		}
+		return s
+	case *validator.IntValidator:
+		s := &schema{zod: "z.number().int()", ts: "number", zero: "0"}
+
+		if x.Enums != nil {
+			return numberEnum(s, x.Enums)
+		}
+
+		// skip bounds of bit size, which are defaults
+		if x.Minimum != nil && *x.Minimum != validator.MinInt(x.BitSize) {
+			s.zod += minimum(strconv.FormatInt(*x.Minimum, 10), x.ExclusiveMinimum)
+			s.rejectsZero = *x.Minimum > 0 || (*x.Minimum == 0 && x.ExclusiveMinimum)
+		}
+		if x.Maximum != nil && *x.Maximum != validator.MaxInt(x.BitSize) {
+			s.zod += maximum(strconv.FormatInt(*x.Maximum, 10), x.ExclusiveMaximum)
+			s.rejectsZero = s.rejectsZero || *x.Maximum < 0 || (*x.Maximum == 0 && x.ExclusiveMaximum)
+		}
+		if x.MultipleOf != 0 {
+			s.zod += ".multipleOf(" + strconv.FormatInt(x.MultipleOf, 10) + ")"
+		}
+		return s
+	case *validator.UintValidator:
+		s := &schema{zod: "z.number().int().nonnegative()", ts: "number", zero: "0"}
+
+		if x.Enums != nil {
+			return numberEnum(s, x.Enums)
+		}
+
+		if x.Minimum != 0 || x.ExclusiveMinimum {
+			s.zod += minimum(strconv.FormatUint(x.Minimum, 10), x.ExclusiveMinimum)
+			s.rejectsZero = true
+		}
+		if x.Maximum != validator.MaxUint(x.BitSize) {
+			s.zod += maximum(strconv.FormatUint(x.Maximum, 10), x.ExclusiveMaximum)
+		}
+		if x.MultipleOf != 0 {
+			s.zod += ".multipleOf(" + strconv.FormatUint(x.MultipleOf, 10) + ")"
+		}
+		return s
+	case *validator.FloatValidator:
+		s := &schema{zod: "z.number()", ts: "number", zero: "0"}
+
+		if x.Enums != nil {
+			return numberEnum(s, x.Enums)
+		}
+
+		if x.Minimum != nil {
+			s.zod += minimum(formatFloat(*x.Minimum), x.ExclusiveMinimum)
+			s.rejectsZero = *x.Minimum > 0 || (*x.Minimum == 0 && x.ExclusiveMinimum)
+		}
+		if x.Maximum != nil {
+			s.zod += maximum(formatFloat(*x.Maximum), x.ExclusiveMaximum)
+			s.rejectsZero = s.rejectsZero || *x.Maximum < 0 || (*x.Maximum == 0 && x.ExclusiveMaximum)
+		}
+		if x.MultipleOf != 0 {
+			s.zod += ".multipleOf(" + formatFloat(x.MultipleOf) + ")"
+		}
+		return s
+	case *validator.SliceValidator:
+		elem := g.value(typ.Elem(), x.ElemValidator, indent)
+		s := &schema{zod: "z.array(" + elem.zod + ")", ts: arrayOf(elem.ts), zero: "[]"}
+
+		if x.MinItems > 0 {
+			s.zod += ".min(" + strconv.FormatUint(x.MinItems, 10) + ")"
+			s.rejectsZero = true
+		}
+		if x.MaxItems != nil {
+			s.zod += ".max(" + strconv.FormatUint(*x.MaxItems, 10) + ")"
+		}
+		return s
+	case *validator.MapValidator:
+		key := &schema{zod: "z.string()", ts: "string"}
+		if typesutil.Deref(typ.Key()).Kind() == reflect.String {
+			key = g.value(typ.Key(), x.KeyValidator, indent)
+		}
+		elem := g.value(typ.Elem(), x.ElemValidator, indent)
+
+		s := &schema{zod: "z.record(" + key.zod + ", " + elem.zod + ")", ts: "Record<" + key.ts + ", " + elem.ts + ">", zero: "{}"}
+		if strings.Contains(key.ts, " | ") {
+			s.ts = "Partial<" + s.ts + ">"
+		}
+
+		if x.MinProperties > 0 {
+			s.zod += ".refine((m) => Object.keys(m).length >= " + strconv.FormatUint(x.MinProperties, 10) + `, { message: "too few entries" })`
+			s.rejectsZero = true
+		}
+		if x.MaxProperties != nil {
+			s.zod += ".refine((m) => Object.keys(m).length <= " + strconv.FormatUint(*x.MaxProperties, 10) + `, { message: "too many entries" })`
+		}
+		return s
+	case *validator.StructValidator:
+		if typ.Name() != "" {
+			return &schema{zod: g.declare(typ, x), ts: typ.Name()}
+		}
+		zod, ts := g.object(x, indent)
+		return &schema{zod: zod, ts: ts}
+	}
+
+	// values without rules
+	switch typ.Kind() {
+	case reflect.String:
+		return &schema{zod: "z.string()", ts: "string", zero: `""`}
+	case reflect.Bool:
+		return &schema{zod: "z.boolean()", ts: "boolean", zero: "false"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &schema{zod: "z.number().int()", ts: "number", zero: "0"}
+	case reflect.Float32, reflect.Float64:
+		return &schema{zod: "z.number()", ts: "number", zero: "0"}
+	}
+
+	return &schema{zod: "z.unknown()", ts: "unknown"}
+}
+
+func numberEnum(s *schema, enums interface{}) *schema {
+	values := validator.EnumValues(enums)
+
+	literals := make([]string, len(values))
+	s.rejectsZero = true
+	for i := range values {
+		literals[i] = "z.literal(" + values[i] + ")"
+		if values[i] == "0" {
+			s.rejectsZero = false
+		}
+	}
+
+	if len(literals) == 1 {
+		s.zod = literals[0]
+	} else {
+		s.zod = "z.union([" + strings.Join(literals, ", ") + "])"
+	}
+	s.ts = strings.Join(values, " | ")
+	return s
+}
+
+func minimum(value string, exclusive bool) string {
+	if exclusive {
+		return ".gt(" + value + ")"
+	}
+	return ".min(" + value + ")"
+}
+
+func maximum(value string, exclusive bool) string {
+	if exclusive {
+		return ".lt(" + value + ")"
+	}
+	return ".max(" + value + ")"
+}
+
+func regex(re *regexp.Regexp) string {
+	source, ok := ecmascript.RegExp(re)
+	if !ok {
+		// could not be checked in browser
+		return ""
+	}
+	return ".regex(/" + source + "/u)"
+}
+
+func arrayOf(ts string) string {
+	if strings.Contains(ts, " | ") {
+		return "(" + ts + ")[]"
+	}
+	return ts + "[]"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func defaultLiteral(typ typesutil.Type, defaultValue []byte) string {
+	switch typesutil.Deref(typ).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.Bool:
+		if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); !ok {
+			return string(defaultValue)
+		}
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		// default values of composite kinds are in JSON
+		if _, ok := typesutil.EncodingTextMarshalerTypeReplacer(typ); !ok {
+			var v interface{}
+			if err := json.Unmarshal(defaultValue, &v); err == nil {
+				return literal(v)
+			}
+		}
+	}
+	return literal(string(defaultValue))
+}
+
+func literal(v interface{}) string {
+	buf := bytes.NewBuffer(nil)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(v)
+	return strings.TrimSpace(buf.String())
+}
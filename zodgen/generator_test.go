@@ -0,0 +1,225 @@
+package zodgen
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type Address struct {
+	Zip  string `json:"zip" validate:"@string/^[0-9]{6}$/"`
+	City string `json:"city,omitempty" validate:"@char[1,20]"`
+}
+
+type User struct {
+	Name    string            `json:"name" validate:"@string[1,10]"`
+	Role    string            `json:"role,omitempty" validate:"@string{ADMIN,MEMBER}" default:"MEMBER"`
+	Age     int               `json:"age" validate:"@int[18,120)"`
+	Level   uint              `json:"level,omitempty" validate:"@uint{1,2}"`
+	Score   float64           `json:"score,omitempty" validate:"@float<5,2>[0,100]"`
+	Active  bool              `json:"active,omitempty"`
+	Tags    []string          `json:"tags" validate:"@slice<@string[1,]>[,3]"`
+	Labels  map[string]string `json:"labels,omitempty" validate:"@map<@string[1,],@string[,8]>[,2]"`
+	Meta    map[string]int    `json:"meta"`
+	Emails  []string          `json:"emails,omitempty" validate:"@slice<@string[1,]>[1,]"`
+	Address Address           `json:"address"`
+	Options struct {
+		Theme string `json:"theme,omitempty"`
+	} `json:"options"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
+}
+
+type Node struct {
+	Name     string  `json:"name" validate:"@string[1,]"`
+	Parent   *Node   `json:"parent,omitempty"`
+	Children []*Node `json:"children,omitempty"`
+}
+
+type Tree struct {
+	Root Node `json:"root"`
+}
+
+func ExampleGenerator() {
+	g := NewGenerator("json")
+	if err := g.Add(reflect.TypeOf(User{})); err != nil {
+		panic(err)
+	}
+	fmt.Print(g)
+	// Output:
+	// import { z } from "zod";
+	//
+	// export const AddressSchema = z.object({
+	//   zip: z.string().regex(/^[0-9]{6}$/u),
+	//   city: z.string().min(1).max(20).or(z.literal("")).optional(),
+	// });
+	//
+	// export interface Address {
+	//   zip: string;
+	//   city?: string | "";
+	// }
+	//
+	// export const UserSchema = z.object({
+	//   name: z.string().min(1).max(10),
+	//   role: z.enum(["ADMIN", "MEMBER"]).or(z.literal("")).default("MEMBER"),
+	//   age: z.number().int().min(18).lt(120),
+	//   level: z.union([z.literal(1), z.literal(2)]).or(z.literal(0)).optional(),
+	//   score: z.number().min(0).max(100).optional(),
+	//   active: z.boolean().optional(),
+	//   tags: z.array(z.string().min(1)).max(3).min(1),
+	//   labels: z.record(z.string().min(1), z.string().max(8).min(1)).refine((m) => Object.keys(m).length <= 2, { message: "too many entries" }).optional(),
+	//   meta: z.record(z.string(), z.number().int()).refine((m) => Object.keys(m).length > 0, { message: "missing required field" }),
+	//   emails: z.array(z.string().min(1)).min(1).or(z.tuple([])).optional(),
+	//   address: AddressSchema,
+	//   options: z.object({
+	//     theme: z.string().optional(),
+	//   }),
+	//   createdAt: z.string().optional(),
+	// });
+	//
+	// export interface User {
+	//   name: string;
+	//   role?: "ADMIN" | "MEMBER" | "";
+	//   age: number;
+	//   level?: 1 | 2 | 0;
+	//   score?: number;
+	//   active?: boolean;
+	//   tags: string[];
+	//   labels?: Record<string, string>;
+	//   meta: Record<string, number>;
+	//   emails?: string[];
+	//   address: Address;
+	//   options: {
+	//     theme?: string;
+	//   };
+	//   createdAt?: string;
+	// }
+}
+
+func TestGenerator(t *testing.T) {
+	t.Run("not named struct", func(t *testing.T) {
+		require.Error(t, NewGenerator("json").Add(reflect.TypeOf(struct{}{})))
+		require.Error(t, NewGenerator("json").Add(reflect.TypeOf("")))
+	})
+
+	t.Run("enum with empty value", func(t *testing.T) {
+		type Filter struct {
+			Mode  string `json:"mode" validate:"@string{,ASC,DESC}"`
+			Order string `json:"order,omitempty" validate:"@string{,ASC,DESC}"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Filter{})))
+		require.Contains(t, g.String(), `mode: z.enum(["", "ASC", "DESC"]).refine((v) => v !== "", { message: "missing required field" }),`)
+		require.Contains(t, g.String(), `order: z.enum(["", "ASC", "DESC"]).optional(),`)
+	})
+
+	t.Run("optional pointers", func(t *testing.T) {
+		type Query struct {
+			Size  *int    `json:"size,omitempty" validate:"@int[1,10]"`
+			Order *string `json:"order,omitempty" validate:"@string{ASC,DESC}"`
+			Size2 int     `json:"size2,omitempty" validate:"@int[1,10]"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Query{})))
+		require.Contains(t, g.String(), "size: z.number().int().min(1).max(10).optional(),")
+		require.Contains(t, g.String(), `order: z.enum(["ASC", "DESC"]).optional(),`)
+		require.Contains(t, g.String(), "size2: z.number().int().min(1).max(10).or(z.literal(0)).optional(),")
+		require.Contains(t, g.String(), "  size?: number;\n  order?: \"ASC\" | \"DESC\";\n  size2?: number | 0;\n")
+	})
+
+	t.Run("presence", func(t *testing.T) {
+		type Counter struct {
+			Count int    `json:"count" validate:"@int[0,10]!"`
+			Note  string `json:"note" validate:"@string[,10]!"`
+			Step  int    `json:"step,omitempty" validate:"@int[1,10]!"`
+			Size  int    `json:"size" validate:"@int[0,10]"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Counter{})))
+		require.Contains(t, g.String(), "  count: z.number().int().min(0).max(10),\n")
+		require.Contains(t, g.String(), "  note: z.string().max(10),\n")
+		require.Contains(t, g.String(), "  step: z.number().int().min(1).max(10).optional(),\n")
+		require.Contains(t, g.String(), `  size: z.number().int().min(0).max(10).refine((v) => v !== 0, { message: "missing required field" }),`)
+	})
+
+	t.Run("composite defaults", func(t *testing.T) {
+		type Settings struct {
+			Tags   []string          `json:"tags,omitempty" default:"[\"a\", \"b\"]"`
+			Labels map[string]string `json:"labels,omitempty" default:"{\"env\":\"dev\"}"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Settings{})))
+		require.Contains(t, g.String(), `  tags: z.array(z.string().min(1)).default(["a","b"]),`)
+		require.Contains(t, g.String(), `  labels: z.record(z.string(), z.string()).default({"env":"dev"}),`)
+	})
+
+	t.Run("provided defaults", func(t *testing.T) {
+		type Event struct {
+			At    string `json:"at,omitempty" default:"$now"`
+			Price string `json:"price,omitempty" default:"$$5"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Event{})))
+		require.Contains(t, g.String(), "  at: z.string().optional(),\n")
+		require.Contains(t, g.String(), `  price: z.string().default("$5"),`)
+	})
+
+	t.Run("sql null types", func(t *testing.T) {
+		type Profile struct {
+			Name sql.NullString `json:"name" validate:"@string[1,10]"`
+			Nick sql.NullString `json:"nick,omitempty" validate:"@string[1,10]"`
+			Age  sql.NullInt64  `json:"age,omitempty" validate:"@int[0,120]"`
+			At   sql.NullTime   `json:"at,omitempty"`
+		}
+
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Profile{})))
+		require.Contains(t, g.String(), `  name: z.string().min(1).max(10),
+  nick: z.string().min(1).max(10).nullable().optional(),
+  age: z.number().int().min(0).max(120).nullable().optional(),
+  at: z.string().nullable().optional(),
+`)
+		require.Contains(t, g.String(), "  name: string;\n  nick?: string | null;\n  age?: number | null;\n  at?: string | null;\n")
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Node{})))
+		require.Equal(t, `import { z } from "zod";
+
+export const NodeSchema: z.ZodType<Node> = z.object({
+  name: z.string().min(1),
+  parent: z.lazy(() => NodeSchema).optional(),
+  children: z.array(z.lazy(() => NodeSchema)).optional(),
+});
+
+export interface Node {
+  name: string;
+  parent?: Node;
+  children?: Node[];
+}
+`, g.String())
+	})
+
+	t.Run("recursive referred by others", func(t *testing.T) {
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(Tree{})))
+		require.Contains(t, g.String(), "export const NodeSchema: z.ZodType<Node> = z.object({")
+		require.Contains(t, g.String(), "export const TreeSchema = z.object({\n  root: NodeSchema,\n});")
+	})
+
+	t.Run("declared once", func(t *testing.T) {
+		g := NewGenerator("json")
+		require.NoError(t, g.Add(reflect.TypeOf(&User{})))
+		require.NoError(t, g.Add(reflect.TypeOf(Address{})))
+		require.Len(t, g.decls, 2)
+	})
+}